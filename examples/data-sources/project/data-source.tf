@@ -0,0 +1,7 @@
+data "project" "myproject" {
+  key = "myproj"
+}
+
+output "project_admins_can_manage_members" {
+  value = data.project.myproject.admin_privileges.manage_members
+}
@@ -0,0 +1,19 @@
+data "project_access_diagnostics" "current" {}
+
+resource "project" "myproject" {
+  key          = "myproj"
+  display_name = "My Project"
+
+  admin_privileges {
+    manage_members   = true
+    manage_resources = true
+    index_resources  = true
+  }
+
+  lifecycle {
+    precondition {
+      condition     = contains(["Enterprise Plus", "Enterprise Plus Trial"], data.project_access_diagnostics.current.license_type)
+      error_message = "Projects require an Enterprise+ license."
+    }
+  }
+}
@@ -0,0 +1,24 @@
+data "project_environments" "all" {}
+
+output "non_standard_environments" {
+  value = [
+    for env in data.project_environments.all.environments : env.full_name
+    if !contains(["staging", "qa"], env.name)
+  ]
+}
+
+data "project_environments" "myproject" {
+  project_key = "myproj"
+}
+
+resource "project_role" "deployer" {
+  name        = "deployer"
+  type        = "CUSTOM"
+  project_key = "myproj"
+
+  environments = [
+    for env in data.project_environments.myproject.environments : env.full_name
+    if env.global || env.name == "staging"
+  ]
+  actions = ["READ_REPOSITORY", "ANNOTATE_REPOSITORY"]
+}
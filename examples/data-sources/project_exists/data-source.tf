@@ -0,0 +1,16 @@
+data "project_exists" "myproject" {
+  key = "myproj"
+}
+
+resource "project_user" "myuser" {
+  project_key = "myproj"
+  name        = "myuser"
+  roles       = ["Developer"]
+
+  lifecycle {
+    precondition {
+      condition     = data.project_exists.myproject.exists
+      error_message = "Project 'myproj' must be created before adding members."
+    }
+  }
+}
@@ -0,0 +1,11 @@
+data "project_group" "developers" {
+  project_key = "myproj"
+  name        = "developers"
+}
+
+check "no_admin_group" {
+  assert {
+    condition     = !contains(data.project_group.developers.roles, "Project Admin")
+    error_message = "Group 'developers' must not hold the 'Project Admin' role in project 'myproj'."
+  }
+}
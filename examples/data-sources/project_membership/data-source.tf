@@ -0,0 +1,11 @@
+data "project_membership" "myproject" {
+  project_key = "myproj"
+}
+
+locals {
+  membership = jsondecode(data.project_membership.myproject.membership_json)
+}
+
+output "myproject_admins" {
+  value = [for user in local.membership.users : user.name if contains(user.roles, "project admin")]
+}
@@ -0,0 +1,7 @@
+data "project_release_bundles" "myproject" {
+  project_key = "myproj"
+}
+
+output "myproject_release_bundles" {
+  value = [for rb in data.project_release_bundles.myproject.release_bundles : rb.name]
+}
@@ -0,0 +1,12 @@
+data "project_repositories" "maven_local" {
+  project_key  = "myproj"
+  package_type = "maven"
+  rclass       = "local"
+}
+
+resource "project_share_repository" "shared" {
+  for_each = toset(data.project_repositories.maven_local.keys)
+
+  repo_key           = each.value
+  target_project_key = "otherproj"
+}
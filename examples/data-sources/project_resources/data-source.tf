@@ -0,0 +1,7 @@
+data "project_resources" "myproject" {
+  project_key = "myproj"
+}
+
+output "myproject_repositories" {
+  value = data.project_resources.myproject.repositories
+}
@@ -0,0 +1,16 @@
+data "project_roles" "myproject" {
+  project_key = "myproj"
+}
+
+resource "project_user" "myuser" {
+  project_key = "myproj"
+  name        = "myuser"
+  roles       = ["Developer"]
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.project_roles.myproject.roles[*].name, "Developer")
+      error_message = "Role 'Developer' doesn't exist in project 'myproj'."
+    }
+  }
+}
@@ -0,0 +1,13 @@
+data "project_unassigned_repositories" "all" {}
+
+resource "project_repository" "maven_releases" {
+  project_key = "myproj"
+  key         = "maven-releases"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.project_unassigned_repositories.all.keys, "maven-releases")
+      error_message = "Repository 'maven-releases' is already assigned to a project."
+    }
+  }
+}
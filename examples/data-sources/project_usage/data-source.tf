@@ -0,0 +1,10 @@
+data "project_usage" "myproj" {
+  project_key = "myproj"
+}
+
+check "storage_quota" {
+  assert {
+    condition     = coalesce(data.project_usage.myproj.quota_percentage, 0) < 80
+    error_message = "Project 'myproj' uses ${data.project_usage.myproj.quota_percentage}% of its storage quota."
+  }
+}
@@ -0,0 +1,12 @@
+data "project_user" "oncall" {
+  project_key = "myproj"
+  name        = "oncall"
+}
+
+resource "project_user" "break_glass" {
+  count = contains(data.project_user.oncall.roles, "Project Admin") ? 0 : 1
+
+  project_key = "myproj"
+  name        = "oncall"
+  roles       = setunion(data.project_user.oncall.roles, ["Release Manager"])
+}
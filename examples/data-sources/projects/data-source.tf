@@ -0,0 +1,12 @@
+data "projects" "team" {
+  key_prefix         = "team"
+  display_name_regex = "^Team "
+}
+
+resource "project_user" "auditor" {
+  for_each = { for p in data.projects.team.projects : p.key => p }
+
+  project_key = each.key
+  name        = "auditor"
+  roles       = ["Viewer"]
+}
@@ -0,0 +1,5 @@
+ephemeral "project_user_grant" "break_glass" {
+  project_key = "myproj"
+  name        = "oncall-engineer"
+  roles       = ["Project Admin"]
+}
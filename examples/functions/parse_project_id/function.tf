@@ -0,0 +1,12 @@
+locals {
+  member = provider::project::parse_project_id("myproj:alice")
+}
+
+import {
+  to = project_user.alice
+  id = "${local.member.project_key}:${local.member.name}"
+}
+
+output "member_project_key" {
+  value = local.member.project_key # "myproj"
+}
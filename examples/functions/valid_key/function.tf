@@ -0,0 +1,19 @@
+variable "team" {
+  type = string
+}
+
+resource "project" "team" {
+  key          = "${var.team}-prj"
+  display_name = "${var.team} project"
+
+  lifecycle {
+    precondition {
+      condition     = provider::project::valid_key("${var.team}-prj")
+      error_message = "Team name must produce a valid project key."
+    }
+  }
+}
+
+output "valid" {
+  value = provider::project::valid_key("1proj") # false
+}
@@ -1 +1,5 @@
-terraform import project.myproject myproj
\ No newline at end of file
+# The members, groups, custom roles and repositories of the project are imported as well.
+# use_project_user_resource, use_project_group_resource, use_project_role_resource and
+# use_project_repository_resource are set to false when the project has any of them, and
+# to true otherwise. Set them accordingly in the configuration.
+terraform import project.myproject myproj
@@ -0,0 +1 @@
+terraform import project_global_role_assignment.auditors project_key:member_type:name:role
@@ -0,0 +1,6 @@
+resource "project_global_role_assignment" "auditors" {
+  project_key = "myproj"
+  role        = "Security Auditor"
+  member_type = "group"
+  name        = "security-team"
+}
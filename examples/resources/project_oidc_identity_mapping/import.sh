@@ -0,0 +1 @@
+terraform import project_oidc_identity_mapping.github_ci project_key:provider_name:name
@@ -0,0 +1,16 @@
+resource "project_oidc_identity_mapping" "github_ci" {
+  project_key   = "myproj"
+  provider_name = "github-oidc"
+  name          = "myproj-ci"
+  description   = "CI pipelines of myorg/myrepo"
+  priority      = 1
+
+  claims_json = jsonencode({
+    repository = "myorg/myrepo"
+  })
+
+  roles      = ["Developer"]
+  username   = "{{sub}}"
+  audience   = "*@*"
+  expires_in = 300
+}
@@ -0,0 +1 @@
+terraform import project_repositories.myprojectrepos project_key
\ No newline at end of file
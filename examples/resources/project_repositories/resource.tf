@@ -0,0 +1,8 @@
+resource "project_repositories" "myprojectrepos" {
+  project_key = "myproj"
+  repo_keys = [
+    "my-generic-local",
+    "my-docker-local",
+    "my-maven-remote",
+  ]
+}
@@ -0,0 +1,9 @@
+resource "project_scoped_token" "ci" {
+  project_key    = "myproj"
+  roles          = ["Developer"]
+  username       = "myproj-ci"
+  description    = "Token of the CI pipelines of myproj"
+  expires_in     = 2592000 # 30 days
+  refreshable    = true
+  refresh_window = "168h"
+}
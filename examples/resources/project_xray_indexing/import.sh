@@ -0,0 +1 @@
+terraform import project_xray_indexing.myproject myproj
\ No newline at end of file
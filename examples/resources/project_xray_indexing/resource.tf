@@ -0,0 +1,6 @@
+resource "project_xray_indexing" "myproject" {
+  project_key   = "myproj"
+  enabled       = true
+  package_types = ["docker", "maven"]
+  exclude       = ["myproj-maven-legacy"]
+}
\ No newline at end of file
@@ -1,11 +1,11 @@
 package main
 
 import (
-	"context"
 	"flag"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/jfrog/terraform-provider-project/pkg/project"
 )
 
@@ -19,12 +19,15 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		// TODO: Update this string with the published name of your provider.
-		Address: "registry.terraform.io/jfrog/project",
-		Debug:   debug,
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
-	err := providerserver.Serve(context.Background(), project.NewProvider(), opts)
+
+	// Error diagnostics include the correlation ID sent with every API call
+	server := project.WithCorrelationId(providerserver.NewProtocol6(project.NewProvider()()))
+
+	err := tf6server.Serve("registry.terraform.io/jfrog/project", server, opts...)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
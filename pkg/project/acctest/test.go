@@ -110,6 +110,33 @@ func GetTestResty(t *testing.T) *resty.Client {
 	return restyClient
 }
 
+// GetSweeperResty returns a client for sweepers, which run outside of any test so
+// configuration errors are returned instead of failing a test.
+func GetSweeperResty() (*resty.Client, error) {
+	projectUrl := os.Getenv("JFROG_URL")
+	if projectUrl == "" {
+		projectUrl = os.Getenv("PROJECT_URL")
+	}
+	if projectUrl == "" {
+		return nil, fmt.Errorf("JFROG_URL or PROJECT_URL must be set for sweepers")
+	}
+
+	accessToken := os.Getenv("PROJECT_ACCESS_TOKEN")
+	if accessToken == "" {
+		accessToken = os.Getenv("JFROG_ACCESS_TOKEN")
+	}
+	if accessToken == "" {
+		return nil, fmt.Errorf("PROJECT_ACCESS_TOKEN or JFROG_ACCESS_TOKEN must be set for sweepers")
+	}
+
+	restyClient, err := client.Build(projectUrl, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.AddAuth(restyClient, "", accessToken)
+}
+
 var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 
 func RandSeq(n int) string {
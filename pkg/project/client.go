@@ -0,0 +1,617 @@
+package project
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+	"golang.org/x/net/http/httpproxy"
+)
+
+const accessPingUrl = "/access/api/v1/system/ping"
+const accessVersionUrl = "/access/api/v1/system/version"
+
+const (
+	defaultReadMaxRetries         = 20
+	defaultWriteMaxRetries        = 3
+	defaultGatewayErrorMaxRetries = 5
+)
+
+const (
+	defaultRetryWaitMin = 100 * time.Millisecond
+	defaultRetryWaitMax = 2 * time.Second
+	// Longest delay requested by a Retry-After header that is honored
+	maxRetryAfter = time.Minute
+)
+
+// Throttling responses which tell how long to wait in the Retry-After header
+var retryAfterStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// Methods that never change server side state and are therefore always safe to retry
+var readMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+}
+
+func isReadRequest(req *resty.Request) bool {
+	return lo.Contains(readMethods, req.Method)
+}
+
+// Methods that can be sent again with the same outcome, even when the first request was
+// processed before the response was lost
+var idempotentMethods = append([]string{http.MethodPut, http.MethodDelete}, readMethods...)
+
+// Responses of a load balancer or reverse proxy when Artifactory is briefly unavailable,
+// e.g. during an upgrade
+var gatewayErrorStatusCodes = []int{
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// configureRetries replaces the single retry count set by client.Build with separate
+// limits for read and write requests. Idempotent requests that fail to complete (e.g.
+// connection errors) and requests throttled by the platform (429, and 503 for idempotent
+// requests) are retried. Throttled requests wait for the delay of the Retry-After header
+// instead of the backoff. Idempotent requests failing with a gateway error (502, 503 and 504) are
+// also retried, up to their own limit.
+func configureRetries(restyClient *resty.Client, readMaxRetries, writeMaxRetries, gatewayErrorMaxRetries int, waitMin, waitMax time.Duration) *resty.Client {
+	return restyClient.
+		SetRetryCount(max(readMaxRetries, writeMaxRetries, gatewayErrorMaxRetries)).
+		SetRetryWaitTime(waitMin).
+		// Raised so the Retry-After delay isn't capped, retryAfter keeps the backoff capped
+		// at waitMax otherwise.
+		SetRetryMaxWaitTime(max(waitMax, maxRetryAfter)).
+		SetRetryAfter(retryAfter(waitMin, waitMax)).
+		AddRetryCondition(func(resp *resty.Response, err error) bool {
+			// resp is nil when the request was never sent, e.g. a middleware failed,
+			// which isn't something a retry would fix.
+			if resp == nil || resp.Request == nil {
+				return false
+			}
+
+			if err == nil && !lo.Contains(retryAfterStatusCodes, resp.StatusCode()) {
+				return false
+			}
+
+			// The platform may have processed the request before the connection failed, or
+			// before a proxy returned a 503, so only requests that can be sent again are retried
+			if (err != nil || resp.StatusCode() == http.StatusServiceUnavailable) && !lo.Contains(idempotentMethods, resp.Request.Method) {
+				return false
+			}
+
+			maxRetries := writeMaxRetries
+			if isReadRequest(resp.Request) {
+				maxRetries = readMaxRetries
+			}
+
+			// Attempt starts at 1 for the initial request
+			return resp.Request.Attempt <= maxRetries
+		}).
+		AddRetryCondition(func(resp *resty.Response, err error) bool {
+			if err != nil || resp == nil || resp.Request == nil {
+				return false
+			}
+
+			return lo.Contains(gatewayErrorStatusCodes, resp.StatusCode()) &&
+				lo.Contains(idempotentMethods, resp.Request.Method) &&
+				resp.Request.Attempt <= gatewayErrorMaxRetries
+		})
+}
+
+// retryAfter returns the delay requested by the Retry-After header of a throttled response,
+// either in seconds or as an HTTP date, or the exponential backoff with jitter between
+// waitMin and waitMax.
+func retryAfter(waitMin, waitMax time.Duration) resty.RetryAfterFunc {
+	return func(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+		if lo.Contains(retryAfterStatusCodes, resp.StatusCode()) {
+			if delay, ok := parseRetryAfter(resp.Header().Get("Retry-After")); ok {
+				// resty falls back to its own backoff for a zero delay, so wait at least the minimum
+				return min(max(delay, waitMin), max(waitMax, maxRetryAfter)), nil
+			}
+		}
+
+		backoff := min(waitMax, waitMin<<min(resp.Request.Attempt, 16))
+		return backoff/2 + rand.N(backoff/2+1), nil
+	}
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(time.Until(date), 0), true
+}
+
+// checkURL validates the configured platform URL before any request is made. The url
+// may come from an environment variable so it hasn't been through the schema validator.
+func checkURL(rawURL string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		diags.AddError(
+			"Invalid URL Configuration",
+			fmt.Sprintf("The url '%s' could not be parsed: %s", rawURL, err),
+		)
+		return diags
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		diags.AddError(
+			"Invalid URL Configuration",
+			fmt.Sprintf("The url '%s' must use the 'http' or 'https' scheme, got '%s'.", rawURL, u.Scheme),
+		)
+		return diags
+	}
+
+	if u.Hostname() == "" {
+		diags.AddError(
+			"Invalid URL Configuration",
+			fmt.Sprintf("The url '%s' has no host.", rawURL),
+		)
+		return diags
+	}
+
+	if u.Path != "" && u.Path != "/" {
+		diags.AddWarning(
+			"URL Path Ignored",
+			fmt.Sprintf("The url '%s' contains the path '%s' which is ignored. The url should be the JFrog Platform base URL, e.g. '%s://%s'.", rawURL, u.Path, u.Scheme, u.Host),
+		)
+	}
+
+	return diags
+}
+
+// checkConnectivity pings the Access API so an unreachable or misconfigured platform
+// is reported once at configure time with a specific cause.
+func checkConnectivity(ctx context.Context, restyClient *resty.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "checkConnectivity")
+
+	resp, err := restyClient.R().
+		SetContext(ctx).
+		Get(accessPingUrl)
+	if err != nil {
+		diags.AddError(
+			"Unable to connect to JFrog Platform",
+			fmt.Sprintf("%s: %s", connectionErrorReason(err), err),
+		)
+		return diags
+	}
+
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		diags.AddError(
+			"Access API not found",
+			fmt.Sprintf("%s returned %d. Check that url points to the JFrog Platform base URL and that the Access service is available.", resp.Request.URL, resp.StatusCode()),
+		)
+	case resp.IsError():
+		diags.AddError(
+			"Access API is not healthy",
+			fmt.Sprintf("%s returned %d: %s", resp.Request.URL, resp.StatusCode(), resp.String()),
+		)
+	}
+
+	return diags
+}
+
+type AccessVersionAPIModel struct {
+	Version string `json:"version"`
+}
+
+// checkAccessVersion fails when the Access service is older than minVersion, so an
+// unsupported platform is reported once at configure time instead of mid-apply.
+func checkAccessVersion(ctx context.Context, restyClient *resty.Client, minVersion string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "checkAccessVersion")
+
+	var accessVersion AccessVersionAPIModel
+	resp, err := restyClient.R().
+		SetContext(ctx).
+		SetResult(&accessVersion).
+		Get(accessVersionUrl)
+	if err != nil {
+		diags.AddAttributeError(path.Root("min_access_version"), "Unable to read Access version", err.Error())
+		return diags
+	}
+	if resp.IsError() {
+		diags.AddAttributeError(
+			path.Root("min_access_version"),
+			"Unable to read Access version",
+			fmt.Sprintf("%s returned %d: %s", resp.Request.URL, resp.StatusCode(), resp.String()),
+		)
+		return diags
+	}
+
+	supported, err := util.CheckVersion(accessVersion.Version, minVersion)
+	if err != nil {
+		diags.AddAttributeError(path.Root("min_access_version"), "Unable to compare Access version", err.Error())
+		return diags
+	}
+
+	if !supported {
+		diags.AddAttributeError(
+			path.Root("min_access_version"),
+			"Unsupported Access version",
+			fmt.Sprintf("The Access service of the platform is version %s, older than the `min_access_version` %s set in the provider configuration. Upgrade the platform, or lower `min_access_version` if the resources in use support this version.", accessVersion.Version, minVersion),
+		)
+	}
+
+	return diags
+}
+
+func connectionErrorReason(err error) string {
+	var dnsErr *net.DNSError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certErr *tls.CertificateVerificationError
+	var netErr net.Error
+
+	switch {
+	case errors.As(err, &dnsErr):
+		return fmt.Sprintf("host '%s' could not be resolved", dnsErr.Name)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return "connection refused, check the host and port of url"
+	case errors.As(err, &unknownAuthorityErr), errors.As(err, &hostnameErr), errors.As(err, &certErr):
+		return "TLS certificate verification failed, set ca_cert_file or ca_cert_pem when the platform uses a private CA"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "connection timed out"
+	default:
+		return "request failed"
+	}
+}
+
+// Schemes of the proxy URLs supported by the transport
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// configureProxy routes requests through proxyURL instead of the proxy set by the
+// HTTP_PROXY and HTTPS_PROXY environment variables, which are used by default. Hosts listed
+// in the NO_PROXY environment variable still bypass the proxy.
+func configureProxy(restyClient *resty.Client, proxyURL string) (*resty.Client, error) {
+	if proxyURL == "" {
+		return restyClient, nil
+	}
+
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy_url '%s': %w", proxyURL, err)
+	}
+	if !lo.Contains(proxySchemes, u.Scheme) || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy_url '%s': must be an URL with one of the schemes %s", proxyURL, strings.Join(proxySchemes, ", "))
+	}
+
+	transport, err := restyClient.Transport()
+	if err != nil {
+		return nil, err
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    httpproxy.FromEnvironment().NoProxy,
+	}).ProxyFunc()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+
+	return restyClient, nil
+}
+
+// tokenFile holds an access token read from a file that is rotated by an external agent,
+// e.g. Vault agent or SPIFFE helper. The file is read again whenever the platform rejects
+// the current token.
+type tokenFile struct {
+	path  string
+	mu    sync.RWMutex
+	token string
+}
+
+func newTokenFile(path string) (*tokenFile, error) {
+	t := &tokenFile{path: path}
+	if _, err := t.reload(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *tokenFile) Token() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.token
+}
+
+// reload reads the file again and reports whether the token has changed
+func (t *tokenFile) reload() (bool, error) {
+	content, err := os.ReadFile(t.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read access token file %s: %w", t.path, err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return false, fmt.Errorf("access token file %s is empty", t.path)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	changed := token != t.token
+	t.token = token
+
+	return changed, nil
+}
+
+// configureTokenFile sets the token from the file on every request and retries a request
+// rejected with 401 once the file contains a new token. The retry count is raised by one so
+// this retry is made even when the other retries are disabled, which are still limited by
+// their own conditions.
+func configureTokenFile(restyClient *resty.Client, t *tokenFile) *resty.Client {
+	return restyClient.
+		SetRetryCount(restyClient.RetryCount + 1).
+		OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+			req.SetAuthToken(t.Token())
+			return nil
+		}).
+		AddRetryCondition(func(resp *resty.Response, err error) bool {
+			if resp == nil || resp.StatusCode() != http.StatusUnauthorized {
+				return false
+			}
+
+			changed, err := t.reload()
+			if err != nil {
+				tflog.Warn(resp.Request.Context(), err.Error())
+				return false
+			}
+
+			return changed
+		})
+}
+
+// clientSettings holds everything that determines the authenticated client. Provider
+// configurations (e.g. aliases) with equal settings share the same client.
+type clientSettings struct {
+	URL                    string
+	AccessToken            string
+	EnvAccessToken         string
+	AccessTokenFile        string
+	OIDC                   oidcConfig
+	TLS                    tlsSettings
+	ProxyURL               string
+	MaxConcurrentRequests  int
+	UserAgentSuffix        string
+	ReadMaxRetries         int
+	WriteMaxRetries        int
+	GatewayErrorMaxRetries int
+	RetryWaitMin           time.Duration
+	RetryWaitMax           time.Duration
+}
+
+type cachedClient struct {
+	once    sync.Once
+	client  *resty.Client
+	version string
+	diags   diag.Diagnostics
+}
+
+// Authenticated clients by settings, shared for the lifetime of the provider process
+var clientCache sync.Map
+
+// sharedClient returns the authenticated client and Artifactory version for the settings,
+// creating them once when configured concurrently by several aliases. Failures aren't cached
+// so a later configuration can try again.
+func sharedClient(ctx context.Context, settings clientSettings) (*resty.Client, string, diag.Diagnostics) {
+	value, _ := clientCache.LoadOrStore(settings, &cachedClient{})
+	cached := value.(*cachedClient)
+
+	cached.once.Do(func() {
+		cached.client, cached.version, cached.diags = newAuthenticatedClient(ctx, settings)
+		if cached.diags.HasError() {
+			clientCache.CompareAndDelete(settings, cached)
+		}
+	})
+
+	return cached.client, cached.version, cached.diags
+}
+
+// Artifactory API keys are 73 characters long and start with this prefix. Unlike access
+// tokens, they can't be used as Bearer tokens.
+const (
+	apiKeyPrefix = "AKCp"
+	apiKeyLength = 73
+)
+
+// isAPIKey reports whether the token is an Artifactory API key instead of an access token
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix) && len(token) == apiKeyLength
+}
+
+func newAuthenticatedClient(ctx context.Context, settings clientSettings) (*resty.Client, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "newAuthenticatedClient", map[string]interface{}{
+		"url":            settings.URL,
+		"correlation_id": correlationId,
+	})
+
+	diags.Append(checkURL(settings.URL)...)
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	restyClient, err := client.Build(settings.URL, productId)
+	if err != nil {
+		diags.AddError(
+			"Error creating Resty client",
+			err.Error(),
+		)
+		return nil, "", diags
+	}
+
+	restyClient = configureRequestLogging(restyClient)
+
+	if settings.UserAgentSuffix != "" {
+		restyClient.SetHeader("User-Agent", fmt.Sprintf("jfrog/%s %s", productId, settings.UserAgentSuffix))
+	}
+
+	restyClient, err = configureTLS(restyClient, settings.TLS)
+	if err != nil {
+		diags.AddError(
+			"Error configuring TLS",
+			err.Error(),
+		)
+		return nil, "", diags
+	}
+
+	restyClient, err = configureProxy(restyClient, settings.ProxyURL)
+	if err != nil {
+		diags.AddError(
+			"Error configuring proxy",
+			err.Error(),
+		)
+		return nil, "", diags
+	}
+
+	restyClient, err = configureConcurrency(restyClient, settings.MaxConcurrentRequests)
+	if err != nil {
+		diags.AddError(
+			"Error configuring concurrency limit",
+			err.Error(),
+		)
+		return nil, "", diags
+	}
+
+	restyClient = configureRetries(restyClient, settings.ReadMaxRetries, settings.WriteMaxRetries, settings.GatewayErrorMaxRetries, settings.RetryWaitMin, settings.RetryWaitMax).
+		SetHeader(correlationIdHeader, correlationId)
+
+	accessToken := settings.EnvAccessToken
+
+	if settings.OIDC.ProviderName != "" {
+		oidcAccessToken, err := oidcTokenExchange(ctx, restyClient, settings.OIDC)
+		if err != nil {
+			diags.AddError(
+				"Failed OIDC ID token exchange",
+				err.Error(),
+			)
+			return nil, "", diags
+		}
+
+		// use token from OIDC provider, which should take precedence over
+		// environment variable data, if found.
+		if oidcAccessToken != "" {
+			accessToken = oidcAccessToken
+		}
+	}
+
+	// Token from file takes precedence over environment variable data
+	// or OIDC access token, if found.
+	var tokenFile *tokenFile
+	if settings.AccessTokenFile != "" && settings.AccessToken == "" {
+		tokenFile, err = newTokenFile(settings.AccessTokenFile)
+		if err != nil {
+			diags.AddError(
+				"Error reading access token file",
+				err.Error(),
+			)
+			return nil, "", diags
+		}
+
+		accessToken = tokenFile.Token()
+	}
+
+	// Check configuration data, which should take precedence over
+	// environment variable data or OIDC access token, if found.
+	if settings.AccessToken != "" {
+		accessToken = settings.AccessToken
+	}
+
+	if accessToken == "" {
+		diags.AddError(
+			"Missing JFrog Access Token",
+			"While configuring the provider, the Access Token was not found in "+
+				"the JFROG_ACCESS_TOKEN/PROJECT_ACCESS_TOKEN environment variable or provider "+
+				"configuration block access_token attribute.",
+		)
+		return nil, "", diags
+	}
+
+	if isAPIKey(accessToken) {
+		diags.AddError(
+			"API key authentication is not supported",
+			"The configured access token is an Artifactory API key. JFrog deprecated API keys "+
+				"and this provider only authenticates with Bearer access tokens. Generate an access "+
+				"token under `Identity and Access` and set it in the access_token attribute or the "+
+				"JFROG_ACCESS_TOKEN environment variable.",
+		)
+		return nil, "", diags
+	}
+
+	restyClient, err = client.AddAuth(restyClient, "", accessToken)
+	if err != nil {
+		diags.AddError(
+			"Error adding Auth to Resty client",
+			err.Error(),
+		)
+		return nil, "", diags
+	}
+
+	if tokenFile != nil {
+		restyClient = configureTokenFile(restyClient, tokenFile)
+	}
+
+	diags.Append(checkConnectivity(ctx, restyClient)...)
+	if diags.HasError() {
+		return nil, "", diags
+	}
+
+	version, err := util.GetArtifactoryVersion(restyClient)
+	if err != nil {
+		diags.AddError(
+			"Error getting Artifactory version",
+			fmt.Sprintf("The provider functionality might be affected by the absence of Artifactory version in the context. %v", err),
+		)
+		return nil, "", diags
+	}
+
+	return restyClient, version, diags
+}
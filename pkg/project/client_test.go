@@ -0,0 +1,163 @@
+package project
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestConfigureRetries(t *testing.T) {
+	testCases := []struct {
+		name             string
+		method           string
+		status           int
+		expectedAttempts int32
+	}{
+		{name: "POST is not retried on 503", method: http.MethodPost, status: http.StatusServiceUnavailable, expectedAttempts: 1},
+		{name: "PATCH is not retried on 503", method: http.MethodPatch, status: http.StatusServiceUnavailable, expectedAttempts: 1},
+		{name: "POST is not retried on 502", method: http.MethodPost, status: http.StatusBadGateway, expectedAttempts: 1},
+		{name: "POST is retried on 429", method: http.MethodPost, status: http.StatusTooManyRequests, expectedAttempts: 3},
+		{name: "PUT is retried on 503", method: http.MethodPut, status: http.StatusServiceUnavailable, expectedAttempts: 4},
+		{name: "GET is retried on 503", method: http.MethodGet, status: http.StatusServiceUnavailable, expectedAttempts: 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			client := configureRetries(resty.New().SetBaseURL(server.URL), 5, 2, 3, time.Millisecond, 2*time.Millisecond)
+
+			if _, err := client.R().Execute(tc.method, "/"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := attempts.Load(); got != tc.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tc.expectedAttempts, got)
+			}
+		})
+	}
+}
+
+func TestConfigureRetries_transportError(t *testing.T) {
+	testCases := []struct {
+		name             string
+		method           string
+		expectedAttempts int32
+	}{
+		{name: "POST is not retried", method: http.MethodPost, expectedAttempts: 1},
+		{name: "PATCH is not retried", method: http.MethodPatch, expectedAttempts: 1},
+		{name: "PUT is retried", method: http.MethodPut, expectedAttempts: 3},
+		{name: "GET is retried", method: http.MethodGet, expectedAttempts: 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				// drop the connection without a response
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err != nil {
+					t.Errorf("failed to hijack connection: %s", err)
+					return
+				}
+				conn.Close()
+			}))
+			defer server.Close()
+
+			client := configureRetries(resty.New().SetBaseURL(server.URL), 5, 2, 3, time.Millisecond, 2*time.Millisecond)
+
+			if _, err := client.R().Execute(tc.method, "/"); err == nil {
+				t.Fatal("expected a transport error")
+			}
+
+			if got := attempts.Load(); got != tc.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tc.expectedAttempts, got)
+			}
+		})
+	}
+}
+
+func TestConfigureTokenFile_withoutRetries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("old-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenFile, err := newTokenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			// the token is rotated while the request is rejected
+			if err := os.WriteFile(path, []byte("new-token"), 0600); err != nil {
+				t.Error(err)
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := configureRetries(resty.New().SetBaseURL(server.URL), 0, 0, 0, time.Millisecond, 2*time.Millisecond)
+	client = configureTokenFile(client, tokenFile)
+
+	resp, err := client.R().Post("/")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, resp.StatusCode())
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestConfigureTokenFile_unchangedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("old-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenFile, err := newTokenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := configureRetries(resty.New().SetBaseURL(server.URL), 0, 0, 0, time.Millisecond, 2*time.Millisecond)
+	client = configureTokenFile(client, tokenFile)
+
+	if _, err := client.R().Get("/"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
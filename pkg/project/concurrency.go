@@ -0,0 +1,66 @@
+package project
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// limitedTransport allows at most cap(slots) requests to be in flight at once. A slot is
+// held until the response body is closed, so reading a large response counts as in flight.
+type limitedTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		<-t.slots
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() { <-t.slots }}
+	return resp, nil
+}
+
+// releasingBody frees the slot of its request once the body is closed
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// configureConcurrency limits the number of requests sent to the platform at once, across
+// all the resources and data sources using the client. Requests wait for a free slot, so
+// parallel Terraform operations are throttled client-side instead of being rate limited.
+// Must be called after any change to the transport of the client.
+func configureConcurrency(restyClient *resty.Client, maxConcurrentRequests int) (*resty.Client, error) {
+	if maxConcurrentRequests <= 0 {
+		return restyClient, nil
+	}
+
+	transport, err := restyClient.Transport()
+	if err != nil {
+		return nil, err
+	}
+
+	return restyClient.SetTransport(&limitedTransport{
+		base:  transport,
+		slots: make(chan struct{}, maxConcurrentRequests),
+	}), nil
+}
@@ -0,0 +1,103 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+const correlationIdHeader = "X-Correlation-ID"
+
+// correlationId identifies every API call made during one Terraform run, i.e. the lifetime
+// of the provider process, so failures can be matched with the platform logs.
+var correlationId = uuid.NewString()
+
+// WithCorrelationId wraps the provider server so that error diagnostics include the
+// correlation ID sent with the API calls.
+func WithCorrelationId(server func() tfprotov6.ProviderServer) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return &correlationServer{
+			ProviderServer: server(),
+		}
+	}
+}
+
+type correlationServer struct {
+	tfprotov6.ProviderServer
+}
+
+func addCorrelationId(diags []*tfprotov6.Diagnostic) {
+	for _, d := range diags {
+		if d == nil || d.Severity != tfprotov6.DiagnosticSeverityError {
+			continue
+		}
+
+		d.Detail = fmt.Sprintf("%s\n\nCorrelation ID: %s", d.Detail, correlationId)
+	}
+}
+
+func (s *correlationServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *correlationServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *correlationServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *correlationServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *correlationServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *correlationServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *correlationServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	resp, err := s.ProviderServer.OpenEphemeralResource(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
+
+func (s *correlationServer) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	resp, err := s.ProviderServer.CloseEphemeralResource(ctx, req)
+	if resp != nil {
+		addCorrelationId(resp.Diagnostics)
+	}
+	return resp, err
+}
@@ -0,0 +1,60 @@
+package project
+
+import (
+	"regexp"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "<REDACTED>"
+
+// Headers and JSON body fields carrying credentials, e.g. the OIDC token exchange
+var (
+	sensitiveHeaders   = []string{"Authorization", "X-JFrog-Art-Api"}
+	sensitiveBodyRegex = regexp.MustCompile(`("(?:access_token|refresh_token|id_token|subject_token|token|password)"\s*:\s*)"[^"]*"`)
+)
+
+func redactBody(body string) string {
+	return sensitiveBodyRegex.ReplaceAllString(body, `${1}"`+redacted+`"`)
+}
+
+// configureRequestLogging logs every API call with tflog, i.e. when TF_LOG is set to DEBUG
+// or TRACE: its method, URL, status and latency once the response is received, or the
+// error when it couldn't be sent. Each retry is logged as a separate attempt. Credentials
+// are also redacted from the request and response dumps of the resty debug mode.
+func configureRequestLogging(restyClient *resty.Client) *resty.Client {
+	return restyClient.
+		OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+			tflog.Debug(resp.Request.Context(), "API call", map[string]interface{}{
+				"method":     resp.Request.Method,
+				"url":        resp.Request.URL,
+				"status":     resp.StatusCode(),
+				"latency_ms": resp.Time().Milliseconds(),
+				"attempt":    resp.Request.Attempt,
+			})
+			return nil
+		}).
+		OnError(func(req *resty.Request, err error) {
+			tflog.Debug(req.Context(), "API call failed", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL,
+				"attempt": req.Attempt,
+				"error":   err.Error(),
+			})
+		}).
+		// Replaces the callback set by client.Build, which only redacts the Authorization header
+		OnRequestLog(func(log *resty.RequestLog) error {
+			for _, header := range sensitiveHeaders {
+				if log.Header.Get(header) != "" {
+					log.Header.Set(header, redacted)
+				}
+			}
+			log.Body = redactBody(log.Body)
+			return nil
+		}).
+		OnResponseLog(func(log *resty.ResponseLog) error {
+			log.Body = redactBody(log.Body)
+			return nil
+		})
+}
@@ -0,0 +1,238 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	oidcIdentitySourceTerraformCloud = "terraform_cloud"
+	oidcIdentitySourceAzure          = "azure"
+	oidcIdentitySourceAWS            = "aws"
+	oidcIdentitySourceGCP            = "gcp"
+	oidcIdentitySourceGitHubActions  = "github_actions"
+)
+
+var oidcIdentitySources = []string{
+	oidcIdentitySourceTerraformCloud,
+	oidcIdentitySourceAzure,
+	oidcIdentitySourceAWS,
+	oidcIdentitySourceGCP,
+	oidcIdentitySourceGitHubActions,
+}
+
+// Azure Instance Metadata Service endpoint for managed identity tokens
+const azureIMDSTokenUrl = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+// GCP metadata server endpoint for ID tokens of the attached service account
+const gcpMetadataIdentityUrl = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/identity"
+
+// Default audience for Azure AD tokens used with workload identity federation
+const defaultAzureOIDCAudience = "api://AzureADTokenExchange"
+
+const metadataRequestTimeout = 10 * time.Second
+
+type oidcConfig struct {
+	ProviderName         string
+	IdentitySource       string
+	Audience             string
+	TFCCredentialTagName string
+}
+
+// oidcTokenExchange obtains an ID token from the configured identity source and exchanges
+// it for a JFrog access token using the OIDC integration named by ProviderName.
+func oidcTokenExchange(ctx context.Context, restyClient *resty.Client, config oidcConfig) (string, error) {
+	var idToken string
+	var err error
+
+	switch config.IdentitySource {
+	case oidcIdentitySourceAzure:
+		idToken, err = azureManagedIdentityToken(ctx, config.Audience)
+	case oidcIdentitySourceAWS:
+		idToken, err = awsWebIdentityToken(ctx)
+	case oidcIdentitySourceGCP:
+		idToken, err = gcpIdentityToken(ctx, config.Audience)
+	case oidcIdentitySourceGitHubActions:
+		idToken, err = githubActionsIDToken(ctx, config.Audience)
+	default:
+		return util.OIDCTokenExchange(ctx, restyClient, config.ProviderName, config.TFCCredentialTagName)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return exchangeIDToken(ctx, restyClient, config.ProviderName, idToken)
+}
+
+func exchangeIDToken(ctx context.Context, restyClient *resty.Client, providerName, idToken string) (string, error) {
+	tflog.Debug(ctx, "exchangeIDToken", map[string]interface{}{
+		"provider_name": providerName,
+	})
+
+	payload := util.OIDCAccessTokenRequest{
+		GrantType:        "urn:ietf:params:oauth:grant-type:token-exchange",
+		SubjectTokenType: "urn:ietf:params:oauth:token-type:id_token",
+		SubjectToken:     idToken,
+		ProviderName:     providerName,
+	}
+
+	var result util.OIDCAccessTokenResponse
+	resp, err := restyClient.R().
+		SetContext(ctx).
+		SetBody(payload).
+		SetResult(&result).
+		Post("/access/api/v1/oidc/token")
+	if err != nil {
+		return "", err
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("%s", resp.String())
+	}
+
+	return result.AccessToken, nil
+}
+
+func newMetadataClient() *resty.Client {
+	return resty.New().
+		SetTimeout(metadataRequestTimeout).
+		SetRetryCount(3)
+}
+
+// azureManagedIdentityToken fetches an Azure AD token for the VM or AKS node managed identity.
+// A user assigned identity is selected with the AZURE_CLIENT_ID environment variable.
+func azureManagedIdentityToken(ctx context.Context, audience string) (string, error) {
+	if audience == "" {
+		audience = defaultAzureOIDCAudience
+	}
+
+	queryParams := map[string]string{
+		"api-version": "2018-02-01",
+		"resource":    audience,
+	}
+	if clientId := util.CheckEnvVars([]string{"AZURE_CLIENT_ID"}, ""); clientId != "" {
+		queryParams["client_id"] = clientId
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+	}
+	resp, err := newMetadataClient().R().
+		SetContext(ctx).
+		SetHeader("Metadata", "true").
+		SetQueryParams(queryParams).
+		SetResult(&result).
+		Get(azureIMDSTokenUrl)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Azure managed identity token: %w", err)
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("failed to get Azure managed identity token: %s", resp.String())
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("Azure managed identity token is empty")
+	}
+
+	return result.AccessToken, nil
+}
+
+// awsWebIdentityToken reads the web identity token that AWS provides for the IAM role of
+// the workload, e.g. the projected service account token of EKS IAM roles for service
+// accounts. The token is signed by the cluster OIDC issuer so it can be exchanged with a
+// JFrog OIDC integration trusting that issuer.
+func awsWebIdentityToken(ctx context.Context) (string, error) {
+	tokenFile := util.CheckEnvVars([]string{"AWS_WEB_IDENTITY_TOKEN_FILE"}, "")
+	if tokenFile == "" {
+		return "", fmt.Errorf("env var AWS_WEB_IDENTITY_TOKEN_FILE is not set")
+	}
+
+	tflog.Debug(ctx, "awsWebIdentityToken", map[string]interface{}{
+		"token_file": tokenFile,
+	})
+
+	content, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read AWS web identity token: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("AWS web identity token file %s is empty", tokenFile)
+	}
+
+	return token, nil
+}
+
+// gcpIdentityToken fetches a Google signed ID token for the service account attached to
+// the GCE instance, GKE workload or Cloud Build worker from the metadata server.
+func gcpIdentityToken(ctx context.Context, audience string) (string, error) {
+	if audience == "" {
+		return "", fmt.Errorf("oidc_audience must be set when oidc_identity_source is '%s'", oidcIdentitySourceGCP)
+	}
+
+	resp, err := newMetadataClient().R().
+		SetContext(ctx).
+		SetHeader("Metadata-Flavor", "Google").
+		SetQueryParams(map[string]string{
+			"audience": audience,
+			"format":   "full",
+		}).
+		Get(gcpMetadataIdentityUrl)
+	if err != nil {
+		return "", fmt.Errorf("failed to get GCP identity token: %w", err)
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("failed to get GCP identity token: %s", resp.String())
+	}
+
+	token := strings.TrimSpace(resp.String())
+	if token == "" {
+		return "", fmt.Errorf("GCP identity token is empty")
+	}
+
+	return token, nil
+}
+
+// githubActionsIDToken requests an ID token for the workflow run from the GitHub Actions
+// token service. The job must have the `id-token: write` permission, which makes GitHub set
+// the ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN environment variables.
+func githubActionsIDToken(ctx context.Context, audience string) (string, error) {
+	requestUrl := util.CheckEnvVars([]string{"ACTIONS_ID_TOKEN_REQUEST_URL"}, "")
+	requestToken := util.CheckEnvVars([]string{"ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, "")
+	if requestUrl == "" || requestToken == "" {
+		return "", fmt.Errorf("env vars ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set, the job needs the 'id-token: write' permission")
+	}
+
+	req := newMetadataClient().R().
+		SetContext(ctx).
+		SetAuthToken(requestToken)
+	// The audience defaults to the URL of the repository owner
+	if audience != "" {
+		req.SetQueryParam("audience", audience)
+	}
+
+	var result struct {
+		Value string `json:"value"`
+	}
+	resp, err := req.
+		SetResult(&result).
+		Get(requestUrl)
+	if err != nil {
+		return "", fmt.Errorf("failed to get GitHub Actions ID token: %w", err)
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("failed to get GitHub Actions ID token: %s", resp.String())
+	}
+	if result.Value == "" {
+		return "", fmt.Errorf("GitHub Actions ID token is empty")
+	}
+
+	return result.Value, nil
+}
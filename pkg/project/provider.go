@@ -3,16 +3,25 @@ package project
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
-	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/util"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
 )
@@ -24,18 +33,41 @@ var productId = "terraform-provider-project/" + Version
 
 // Ensure the implementation satisfies the provider.Provider interface.
 var _ provider.Provider = &ProjectProvider{}
+var _ provider.ProviderWithEphemeralResources = &ProjectProvider{}
+var _ provider.ProviderWithFunctions = &ProjectProvider{}
 
 type ProjectProvider struct {
-	Meta util.ProviderMetadata
+	Meta project.ProviderMetadata
 }
 
 // ProjectProviderModel describes the provider data model.
 type ProjectProviderModel struct {
-	Url                  types.String `tfsdk:"url"`
-	AccessToken          types.String `tfsdk:"access_token"`
-	OIDCProviderName     types.String `tfsdk:"oidc_provider_name"`
-	TFCCredentialTagName types.String `tfsdk:"tfc_credential_tag_name"`
-	CheckLicense         types.Bool   `tfsdk:"check_license"`
+	Url                    types.String `tfsdk:"url"`
+	AccessToken            types.String `tfsdk:"access_token"`
+	AccessTokenFile        types.String `tfsdk:"access_token_file"`
+	OIDCProviderName       types.String `tfsdk:"oidc_provider_name"`
+	OIDCIdentitySource     types.String `tfsdk:"oidc_identity_source"`
+	OIDCAudience           types.String `tfsdk:"oidc_audience"`
+	TFCCredentialTagName   types.String `tfsdk:"tfc_credential_tag_name"`
+	CheckLicense           types.Bool   `tfsdk:"check_license"`
+	ReadMaxRetries         types.Int64  `tfsdk:"read_max_retries"`
+	WriteMaxRetries        types.Int64  `tfsdk:"write_max_retries"`
+	GatewayErrorMaxRetries types.Int64  `tfsdk:"gateway_error_max_retries"`
+	RetryWaitMin           types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax           types.String `tfsdk:"retry_wait_max"`
+	ProtectedProjectKeys   types.Set    `tfsdk:"protected_project_keys"`
+	ValidateRoles          types.Bool   `tfsdk:"validate_roles"`
+	CACertFile             types.String `tfsdk:"ca_cert_file"`
+	CACertPEM              types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify     types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCertFile         types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile          types.String `tfsdk:"client_key_file"`
+	ClientCertPEM          types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM           types.String `tfsdk:"client_key_pem"`
+	ProxyURL               types.String `tfsdk:"proxy_url"`
+	MaxConcurrentRequests  types.Int64  `tfsdk:"max_concurrent_requests"`
+	UserAgentSuffix        types.String `tfsdk:"user_agent_suffix"`
+	MinAccessVersion       types.String `tfsdk:"min_access_version"`
 }
 
 // Metadata satisfies the provider.Provider interface for ProjectProvider
@@ -61,7 +93,15 @@ func (p *ProjectProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
-				Description: "This is a Bearer token that can be given to you by your admin under `Identity and Access`. This can also be sourced from the `PROJECT_ACCESS_TOKEN` or `JFROG_ACCESS_TOKEN` environment variable. Defauult to empty string if not set.",
+				Description: "This is a Bearer token that can be given to you by your admin under `Identity and Access`. This can also be sourced from the `PROJECT_ACCESS_TOKEN` or `JFROG_ACCESS_TOKEN` environment variable. API keys aren't supported. Default to empty string if not set.",
+			},
+			"access_token_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
+				},
+				Description: "Path to a file containing the Bearer token. The file is read again when a request is rejected with `401 Unauthorized`, so short-lived tokens rotated by an external agent (e.g. Vault agent, SPIFFE helper) keep working during long applies. This can also be sourced from the `PROJECT_ACCESS_TOKEN_FILE` or `JFROG_ACCESS_TOKEN_FILE` environment variable. Takes precedence over `oidc_provider_name` and the access token environment variables.",
 			},
 			"oidc_provider_name": schema.StringAttribute{
 				Optional: true,
@@ -70,6 +110,22 @@ func (p *ProjectProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				},
 				Description: "OIDC provider name. See [Configure an OIDC Integration](https://jfrog.com/help/r/jfrog-platform-administration-documentation/configure-an-oidc-integration) for more details.",
 			},
+			"oidc_identity_source": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(oidcIdentitySources...),
+					stringvalidator.AlsoRequires(path.MatchRoot("oidc_provider_name")),
+				},
+				Description: fmt.Sprintf("Where the ID token exchanged with `oidc_provider_name` comes from (%s). `terraform_cloud` uses the `TFC_WORKLOAD_IDENTITY_TOKEN` environment variable. `azure` requests a token for the Azure managed identity from the Instance Metadata Service; set the `AZURE_CLIENT_ID` environment variable to select a user assigned identity. `aws` reads the web identity token of the IAM role from the file in the `AWS_WEB_IDENTITY_TOKEN_FILE` environment variable, e.g. on EKS with IAM roles for service accounts. `gcp` requests an ID token for the attached service account from the GCP metadata server, e.g. on GKE with workload identity or Cloud Build. `github_actions` requests an ID token for the workflow run using the `ACTIONS_ID_TOKEN_REQUEST_URL` and `ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variables, which GitHub sets for jobs with the `id-token: write` permission. Default to `terraform_cloud`.", strings.Join(oidcIdentitySources, ", ")),
+			},
+			"oidc_audience": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("oidc_identity_source")),
+				},
+				Description: fmt.Sprintf("Audience of the ID token requested from `oidc_identity_source`. Must match the audience configured on the JFrog OIDC integration. Default to `%s` for `azure`. Required for `gcp`. Default to the URL of the repository owner for `github_actions`. Not used for `aws`, where the audience is set when the token is issued.", defaultAzureOIDCAudience),
+			},
 			"tfc_credential_tag_name": schema.StringAttribute{
 				Optional: true,
 				Validators: []validator.String{
@@ -82,6 +138,134 @@ func (p *ProjectProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Description:        "Toggle for pre-flight checking of Artifactory Enterprise license. Default to `true`.",
 				DeprecationMessage: "Remove this attribute from your provider configuration as it is no longer used and the attribute will be removed in the next major version of the provider.",
 			},
+			"read_max_retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: fmt.Sprintf("Maximum number of times a read (`GET`, `HEAD`, `OPTIONS`) request is retried when it fails to complete, e.g. on connection errors, or is throttled with a `429` or `503` response. Throttled requests wait for the delay of the `Retry-After` header, up to 1 minute. Default to `%d`.", defaultReadMaxRetries),
+			},
+			"write_max_retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: fmt.Sprintf("Maximum number of times a write (`POST`, `PUT`, `PATCH`, `DELETE`) request is throttled with a `429` response, or a `PUT` or `DELETE` request fails to complete, e.g. on connection errors, or is throttled with a `503` response. `POST` and `PATCH` requests are not retried on connection errors or `503`, which may happen after the platform processed them. Throttled requests wait for the delay of the `Retry-After` header, up to 1 minute. Writes may not be idempotent so keep this low. Default to `%d`.", defaultWriteMaxRetries),
+			},
+			"gateway_error_max_retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: fmt.Sprintf("Maximum number of times an idempotent (`GET`, `HEAD`, `OPTIONS`, `PUT`, `DELETE`) request is retried when it fails with a `502`, `503` or `504` response, e.g. returned by a load balancer while Artifactory is upgraded. Retries wait for the backoff with jitter between `retry_wait_min` and `retry_wait_max`. This limit is separate from `read_max_retries` and `write_max_retries`. Default to `%d`.", defaultGatewayErrorMaxRetries),
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: fmt.Sprintf("Delay before the first retry, doubled on every following retry up to `retry_wait_max`, as a duration string, e.g. `500ms` or `1s`. Delays requested by a `Retry-After` header are never shorter than this. Default to `%s`.", defaultRetryWaitMin),
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: fmt.Sprintf("Longest delay between retries when the response has no `Retry-After` header, as a duration string, e.g. `10s`. Must not be less than `retry_wait_min`. Default to `%s`.", defaultRetryWaitMax),
+			},
+			"protected_project_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validatorfw_string.ProjectKey()),
+				},
+				Description: "Keys of projects that must never be destroyed or have their key changed, e.g. `[\"prod\"]`. Any plan that would do so fails regardless of the resource configuration, as an organization-wide safety net.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+				Description: "Path to a file containing PEM encoded CA certificates trusted in addition to the system trust store, e.g. the root CA of a corporate PKI.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "PEM encoded CA certificates trusted in addition to the system trust store. Conflicts with `ca_cert_file`.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When set to `true`, the TLS certificate of the platform isn't verified. Only use this for testing, prefer `ca_cert_file` or `ca_cert_pem` otherwise. Default to `false`.",
+			},
+			"client_cert_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_file")),
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+				Description: "Path to a file containing the PEM encoded client certificate presented to the platform for mutual TLS. Requires `client_key_file`.",
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_file")),
+				},
+				Description: "Path to a file containing the PEM encoded private key of `client_cert_file`.",
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+				Description: "PEM encoded client certificate presented to the platform for mutual TLS. Requires `client_key_pem`. Conflicts with `client_cert_file`.",
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+				Description: "PEM encoded private key of `client_cert_pem`.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Maximum number of requests sent to the platform at once by the provider. Further requests wait until one completes, so large configurations (e.g. hundreds of `project_user` or `project_group` resources) applied with a high `-parallelism` don't trip the rate limits of the platform. Provider aliases with the same settings share the limit. Default to unlimited.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "URL of the proxy requests to the platform are sent through, e.g. `http://proxy.example.com:3128`. The `http`, `https` and `socks5` schemes are supported. Overrides the `HTTP_PROXY` and `HTTPS_PROXY` environment variables, which are used otherwise. Hosts listed in the `NO_PROXY` environment variable bypass the proxy in both cases.",
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[\x20-\x7E]+$`), "must only contain printable ASCII characters"),
+				},
+				Description: "Text appended to the `User-Agent` header of the requests to the platform, e.g. `pipeline/networking workspace/prod`, so the API traffic can be attributed to a pipeline or workspace in the Artifactory request logs.",
+			},
+			"min_access_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+(\.\d+){0,2}$`), "must be a version, e.g. `7.84.3`"),
+				},
+				Description: "Minimum version of the Access service of the platform, e.g. `7.84.3`. When set, the version is read when the provider is configured and an older platform fails the plan with a clear error, instead of the resources failing mid-apply with errors such as `404` from APIs the platform doesn't have yet.",
+			},
+			"validate_roles": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When set to `true`, the roles of `project_user`, `project_group` and of the `member` and `group` blocks of `project` are checked against the roles of the project during plan, so unknown roles fail the plan instead of the apply. This reads the roles of the project for every such resource. Roles created by `project_role` in the same apply aren't known during plan and are reported as unknown. Default to `false`.",
+			},
 		},
 	}
 }
@@ -90,6 +274,8 @@ func (p *ProjectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// Check environment variables, first available OS variable will be assigned to the var
 	url := util.CheckEnvVars([]string{"JFROG_URL", "PROJECT_URL"}, "")
 	accessToken := util.CheckEnvVars([]string{"JFROG_ACCESS_TOKEN", "PROJECT_ACCESS_TOKEN"}, "")
+	accessTokenFile := util.CheckEnvVars([]string{"JFROG_ACCESS_TOKEN_FILE", "PROJECT_ACCESS_TOKEN_FILE"}, "")
+	apiKey := util.CheckEnvVars([]string{"JFROG_API_KEY", "ARTIFACTORY_API_KEY"}, "")
 
 	var config ProjectProviderModel
 
@@ -99,93 +285,173 @@ func (p *ProjectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	// Credentials from ephemeral resources (e.g. Vault) are known during both plan and
+	// apply. Values that are only known after apply can't be used to configure the provider.
+	credentialValues := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"url", config.Url},
+		{"access_token", config.AccessToken},
+		{"access_token_file", config.AccessTokenFile},
+	}
+	for _, v := range credentialValues {
+		if v.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(v.name),
+				"Unknown provider configuration value",
+				fmt.Sprintf("The provider cannot be configured because `%s` is unknown. Source credentials from an ephemeral resource or ephemeral input variable, which are known during plan and apply and are never stored in plan files or state, rather than from a managed resource.", v.name),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if config.Url.ValueString() != "" {
 		url = config.Url.ValueString()
 	}
 
+	if apiKey != "" {
+		resp.Diagnostics.AddWarning(
+			"API key authentication is deprecated",
+			"The JFROG_API_KEY/ARTIFACTORY_API_KEY environment variable is ignored. JFrog "+
+				"deprecated API keys and this provider only authenticates with Bearer access "+
+				"tokens, like the other JFrog providers. Set the access_token attribute or the "+
+				"JFROG_ACCESS_TOKEN environment variable instead.",
+		)
+	}
+
 	if url == "" {
 		resp.Diagnostics.AddError(
 			"Missing URL Configuration",
 			"While configuring the provider, the url was not found in "+
-				"the JFROG_URL/ARTIFACTORY_URL environment variable or provider "+
+				"the JFROG_URL/PROJECT_URL environment variable or provider "+
 				"configuration block url attribute.",
 		)
 		return
 	}
 
-	restyClient, err := client.Build(url, productId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Resty client",
-			err.Error(),
-		)
-		return
+	readMaxRetries := defaultReadMaxRetries
+	if !config.ReadMaxRetries.IsNull() {
+		readMaxRetries = int(config.ReadMaxRetries.ValueInt64())
 	}
 
-	oidcProviderName := config.OIDCProviderName.ValueString()
-	if oidcProviderName != "" {
-		oidcAccessToken, err := util.OIDCTokenExchange(ctx, restyClient, oidcProviderName, config.TFCCredentialTagName.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Failed OIDC ID token exchange",
-				err.Error(),
-			)
-			return
-		}
+	writeMaxRetries := defaultWriteMaxRetries
+	if !config.WriteMaxRetries.IsNull() {
+		writeMaxRetries = int(config.WriteMaxRetries.ValueInt64())
+	}
 
-		// use token from OIDC provider, which should take precedence over
-		// environment variable data, if found.
-		if oidcAccessToken != "" {
-			accessToken = oidcAccessToken
-		}
+	gatewayErrorMaxRetries := defaultGatewayErrorMaxRetries
+	if !config.GatewayErrorMaxRetries.IsNull() {
+		gatewayErrorMaxRetries = int(config.GatewayErrorMaxRetries.ValueInt64())
 	}
 
-	// Check configuration data, which should take precedence over
-	// environment variable data or OIDC access token, if found.
-	if config.AccessToken.ValueString() != "" {
-		accessToken = config.AccessToken.ValueString()
+	retryWaitMin := parseRetryWait(path.Root("retry_wait_min"), config.RetryWaitMin, defaultRetryWaitMin, &resp.Diagnostics)
+	retryWaitMax := parseRetryWait(path.Root("retry_wait_max"), config.RetryWaitMax, defaultRetryWaitMax, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if accessToken == "" {
-		resp.Diagnostics.AddError(
-			"Missing JFrog Access Token",
-			"While configuring the provider, the Access Token was not found in "+
-				"the JFROG_ACCESS_TOKEN/PROJECT_ACCESS_TOKEN environment variable or provider "+
-				"configuration block access_token attribute.",
+	if retryWaitMax < retryWaitMin {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Invalid retry_wait_max",
+			fmt.Sprintf("retry_wait_max (%s) must not be less than retry_wait_min (%s).", retryWaitMax, retryWaitMin),
 		)
 		return
 	}
 
-	restyClient, err = client.AddAuth(restyClient, "", accessToken)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error adding Auth to Resty client",
-			err.Error(),
-		)
+	if config.AccessTokenFile.ValueString() != "" {
+		accessTokenFile = config.AccessTokenFile.ValueString()
 	}
 
-	version, err := util.GetArtifactoryVersion(restyClient)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting Artifactory version",
-			fmt.Sprintf("The provider functionality might be affected by the absence of Artifactory version in the context. %v", err),
-		)
+	// Aliases with the same settings share one authenticated client, so e.g. the OIDC
+	// token exchange happens once instead of once per alias.
+	restyClient, version, diags := sharedClient(ctx, clientSettings{
+		URL:             url,
+		AccessToken:     config.AccessToken.ValueString(),
+		EnvAccessToken:  accessToken,
+		AccessTokenFile: accessTokenFile,
+		OIDC: oidcConfig{
+			ProviderName:         config.OIDCProviderName.ValueString(),
+			IdentitySource:       config.OIDCIdentitySource.ValueString(),
+			Audience:             config.OIDCAudience.ValueString(),
+			TFCCredentialTagName: config.TFCCredentialTagName.ValueString(),
+		},
+		TLS: tlsSettings{
+			CACertFile:         config.CACertFile.ValueString(),
+			CACertPEM:          config.CACertPEM.ValueString(),
+			InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+			ClientCertFile:     config.ClientCertFile.ValueString(),
+			ClientKeyFile:      config.ClientKeyFile.ValueString(),
+			ClientCertPEM:      config.ClientCertPEM.ValueString(),
+			ClientKeyPEM:       config.ClientKeyPEM.ValueString(),
+		},
+		ProxyURL:               config.ProxyURL.ValueString(),
+		MaxConcurrentRequests:  int(config.MaxConcurrentRequests.ValueInt64()),
+		UserAgentSuffix:        config.UserAgentSuffix.ValueString(),
+		ReadMaxRetries:         readMaxRetries,
+		WriteMaxRetries:        writeMaxRetries,
+		GatewayErrorMaxRetries: gatewayErrorMaxRetries,
+		RetryWaitMin:           retryWaitMin,
+		RetryWaitMax:           retryWaitMax,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if config.MinAccessVersion.ValueString() != "" {
+		resp.Diagnostics.Append(checkAccessVersion(ctx, restyClient, config.MinAccessVersion.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
 	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
 
-	meta := util.ProviderMetadata{
-		Client:             restyClient,
-		ProductId:          productId,
-		ArtifactoryVersion: version,
+	var protectedProjectKeys []string
+	resp.Diagnostics.Append(config.ProtectedProjectKeys.ElementsAs(ctx, &protectedProjectKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	meta := project.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{
+			Client:             restyClient,
+			ProductId:          productId,
+			ArtifactoryVersion: version,
+		},
+		ProtectedProjectKeys: protectedProjectKeys,
+		ValidateRoles:        config.ValidateRoles.ValueBool(),
 	}
 
 	p.Meta = meta
 
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+// parseRetryWait returns the duration of the attribute, or defaultValue when it isn't set
+func parseRetryWait(attrPath path.Path, value types.String, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() {
+		return defaultValue
+	}
+
+	wait, err := time.ParseDuration(value.ValueString())
+	if err != nil || wait <= 0 {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid retry wait duration",
+			fmt.Sprintf("'%s' must be a positive duration, e.g. '500ms' or '2s'.", value.ValueString()),
+		)
+		return defaultValue
+	}
+
+	return wait
 }
 
 // Resources satisfies the provider.Provider interface for ProjectProvider.
@@ -193,18 +459,53 @@ func (p *ProjectProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		project.NewProjectResource,
 		project.NewProjectEnvironmentResource,
+		project.NewProjectGlobalRoleAssignmentResource,
 		project.NewProjectGroupResource,
+		project.NewProjectOIDCIdentityMappingResource,
 		project.NewProjectRepositoryResource,
+		project.NewProjectRepositoriesResource,
 		project.NewProjectRoleResource,
+		project.NewProjectScopedTokenResource,
 		project.NewProjectShareRepositoryResource,
 		project.NewProjectShareRepositoryWithAllResource,
 		project.NewProjectUserResource,
+		project.NewProjectXrayIndexingResource,
 	}
 }
 
 // DataSources satisfies the provider.Provider interface for ProjectProvider.
 func (p *ProjectProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		project.NewProjectDataSource,
+		project.NewProjectAccessDiagnosticsDataSource,
+		project.NewProjectEnvironmentsDataSource,
+		project.NewProjectExistsDataSource,
+		project.NewProjectGroupDataSource,
+		project.NewProjectMembershipDataSource,
+		project.NewProjectReleaseBundlesDataSource,
+		project.NewProjectRepositoriesDataSource,
+		project.NewProjectResourcesDataSource,
+		project.NewProjectRolesDataSource,
+		project.NewProjectUnassignedRepositoriesDataSource,
+		project.NewProjectUsageDataSource,
+		project.NewProjectUserDataSource,
+		project.NewProjectsDataSource,
+	}
+}
+
+// Functions satisfies the provider.ProviderWithFunctions interface for ProjectProvider.
+func (p *ProjectProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		project.NewParseProjectIdFunction,
+		project.NewValidKeyFunction,
+	}
+}
+
+// EphemeralResources satisfies the provider.ProviderWithEphemeralResources interface for ProjectProvider.
+func (p *ProjectProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		project.NewProjectUserGrantEphemeralResource,
+	}
 }
 
 func NewProvider() func() provider.Provider {
@@ -0,0 +1,57 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// Project keys up to this length are accepted by every supported platform version
+const shortProjectKeyMaxLength = 10
+
+// Platform version accepting project keys longer than shortProjectKeyMaxLength, see the
+// Artifactory 7.56.2 entry of the self-hosted release notes:
+// https://jfrog.com/help/r/jfrog-release-information/artifactory-self-hosted-releases
+const longProjectKeyMinVersion = "7.56.2"
+
+// Platform versions introducing role actions that older versions reject, see the
+// Artifactory 7.63.2 entry of the self-hosted release notes for the Xray metadata actions:
+// https://jfrog.com/help/r/jfrog-release-information/artifactory-self-hosted-releases
+var roleActionMinVersions = map[string]string{
+	"MANAGE_XRAY_MD_REPOSITORY":     "7.63.2",
+	"MANAGE_XRAY_MD_RELEASE_BUNDLE": "7.63.2",
+	"MANAGE_XRAY_MD_BUILD":          "7.63.2",
+}
+
+// requireVersion reports an error on the attribute when the platform is older than
+// minVersion, so the plan fails instead of the API rejecting the request during apply.
+// Nothing is checked when the platform version isn't known.
+func (m ProviderMetadata) requireVersion(attrPath path.Path, feature, minVersion string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.ArtifactoryVersion == "" {
+		return diags
+	}
+
+	supported, err := util.CheckVersion(m.ArtifactoryVersion, minVersion)
+	if err != nil {
+		diags.AddAttributeWarning(
+			attrPath,
+			"Failed to check Artifactory version",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if !supported {
+		diags.AddAttributeError(
+			attrPath,
+			"Unsupported Artifactory version",
+			fmt.Sprintf("%s requires platform >= %s. Current version: %s", feature, minVersion, m.ArtifactoryVersion),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,44 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestRequireVersion(t *testing.T) {
+	testCases := []struct {
+		name            string
+		version         string
+		minVersion      string
+		expectedError   bool
+		expectedWarning bool
+	}{
+		{name: "unknown version", version: "", minVersion: longProjectKeyMinVersion},
+		{name: "below long key version", version: "7.56.1", minVersion: longProjectKeyMinVersion, expectedError: true},
+		{name: "equal to long key version", version: "7.56.2", minVersion: longProjectKeyMinVersion},
+		{name: "above long key version", version: "7.57.0", minVersion: longProjectKeyMinVersion},
+		{name: "below role action version", version: "7.63.1", minVersion: roleActionMinVersions["MANAGE_XRAY_MD_BUILD"], expectedError: true},
+		{name: "equal to role action version", version: "7.63.2", minVersion: roleActionMinVersions["MANAGE_XRAY_MD_BUILD"]},
+		{name: "above role action version", version: "7.104.5", minVersion: roleActionMinVersions["MANAGE_XRAY_MD_BUILD"]},
+		{name: "invalid version", version: "latest", minVersion: longProjectKeyMinVersion, expectedWarning: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := ProviderMetadata{
+				ProviderMetadata: util.ProviderMetadata{ArtifactoryVersion: tc.version},
+			}
+
+			diags := metadata.requireVersion(path.Root("key"), "Feature", tc.minVersion)
+
+			if diags.HasError() != tc.expectedError {
+				t.Errorf("expected error %t, got %v", tc.expectedError, diags)
+			}
+			if (diags.WarningsCount() > 0) != tc.expectedWarning {
+				t.Errorf("expected warning %t, got %v", tc.expectedWarning, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{
+		TypeName: "project",
+	}
+}
+
+type ProjectDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectDataSourceModel struct {
+	Key                          types.String `tfsdk:"key"`
+	DisplayName                  types.String `tfsdk:"display_name"`
+	Description                  types.String `tfsdk:"description"`
+	AdminPrivileges              types.Object `tfsdk:"admin_privileges"`
+	MaxStorageInGibibytes        types.Int64  `tfsdk:"max_storage_in_gibibytes"`
+	BlockDeploymentsOnLimit      types.Bool   `tfsdk:"block_deployments_on_limit"`
+	EnforceQuotaBlockDeployments types.Bool   `tfsdk:"enforce_quota_block_deployments"`
+	EmailNotification            types.Bool   `tfsdk:"email_notification"`
+	IncludeMembers               types.Bool   `tfsdk:"include_members"`
+	IncludeGroups                types.Bool   `tfsdk:"include_groups"`
+	IncludeRepositories          types.Bool   `tfsdk:"include_repositories"`
+	Members                      types.Set    `tfsdk:"members"`
+	Groups                       types.Set    `tfsdk:"groups"`
+	Repos                        types.Set    `tfsdk:"repos"`
+	QuotaWarningThreshold        types.Int64  `tfsdk:"quota_warning_threshold"`
+	QuotaExceeded                types.Bool   `tfsdk:"quota_exceeded"`
+	OverSoftLimit                types.Bool   `tfsdk:"over_soft_limit"`
+}
+
+var memberNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the member.",
+		},
+		"roles": schema.SetAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "Roles of the member in the project.",
+		},
+	},
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Display name of the project.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the project.",
+			},
+			"admin_privileges": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"manage_members": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Allows the Project Admin to manage Platform users/groups as project members with different roles.",
+					},
+					"manage_resources": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Allows the Project Admin to manage resources - repositories, builds and Pipelines resources on the project level.",
+					},
+					"index_resources": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Enables a project admin to define the resources to be indexed by Xray",
+					},
+				},
+				Computed:    true,
+				Description: "Privileges of the Project Admins.",
+			},
+			"max_storage_in_gibibytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Storage quota in GiB. `-1` for unlimited storage.",
+			},
+			"enforce_quota_block_deployments": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether deployment of artifacts is blocked once the storage quota is exceeded.",
+			},
+			"block_deployments_on_limit": schema.BoolAttribute{
+				Computed:           true,
+				Description:        "Whether deployment of artifacts is blocked once the storage quota is exceeded.",
+				DeprecationMessage: "Replaced by `enforce_quota_block_deployments`, which has the same value.",
+			},
+			"email_notification": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether alerts are sent by email when reaching 75% and 95% of the storage quota.",
+			},
+			"include_members": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When set to `true`, the users who are members of the project are read into `members`. Default to `false`.",
+			},
+			"include_groups": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When set to `true`, the groups who are members of the project are read into `groups`. Default to `false`.",
+			},
+			"include_repositories": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When set to `true`, the repositories assigned to the project are read into `repos`. Default to `false`.",
+			},
+			"members": schema.SetNestedAttribute{
+				NestedObject: memberNestedObject,
+				Computed:     true,
+				Description:  "Users who are members of the project. Only set when `include_members` is `true`.",
+			},
+			"groups": schema.SetNestedAttribute{
+				NestedObject: memberNestedObject,
+				Computed:     true,
+				Description:  "Groups who are members of the project. Only set when `include_groups` is `true`.",
+			},
+			"repos": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the repositories assigned to the project. Only set when `include_repositories` is `true`.",
+			},
+			"quota_warning_threshold": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				Description: fmt.Sprintf("Percentage of the storage quota used to compute `over_soft_limit`. Default to `%d`.", defaultQuotaWarningThreshold),
+			},
+			"quota_exceeded": schema.BoolAttribute{
+				Computed:    true,
+				Description: "`true` when the storage usage of the project reached its storage quota. Always `false` for unlimited storage, and null when the usage can't be read.",
+			},
+			"over_soft_limit": schema.BoolAttribute{
+				Computed:    true,
+				Description: "`true` when the storage usage of the project reached `quota_warning_threshold` percent of its storage quota. Always `false` for unlimited storage, and null when the usage can't be read.",
+			},
+		},
+		Description: "Reads an existing project by key, e.g. to reference a project managed by another configuration without importing it.",
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := data.Key.ValueString()
+
+	var project ProjectAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("projectKey", projectKey).
+		SetResult(&project).
+		SetError(&projectError).
+		AddRetryCondition(retryOnIncompleteProject).
+		Get(ProjectUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		UnableToReadDataSourceError(resp, fmt.Sprintf("project '%s' not found", projectKey))
+		return
+	}
+	if response.IsError() {
+		UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	// Included users, groups and repositories are read in parallel
+	details, err := readProjectDetails(
+		ctx,
+		[]string{projectKey},
+		projectDetailsIncludes{
+			Users:  data.IncludeMembers.ValueBool(),
+			Groups: data.IncludeGroups.ValueBool(),
+			Repos:  data.IncludeRepositories.ValueBool(),
+		},
+		d.ProviderData.Client,
+	)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	data.DisplayName = types.StringValue(project.DisplayName)
+	data.Description = types.StringValue(project.Description)
+	data.MaxStorageInGibibytes = types.Int64Value(BytesToGibibytes(project.StorageQuota))
+	data.BlockDeploymentsOnLimit = types.BoolValue(!project.SoftLimit)
+	data.EnforceQuotaBlockDeployments = types.BoolValue(!project.SoftLimit)
+	data.EmailNotification = types.BoolValue(project.QuotaEmailNotification)
+
+	adminPrivileges, ds := types.ObjectValue(adminPrivilegesAttrType, map[string]attr.Value{
+		"manage_members":   types.BoolValue(project.AdminPrivileges.ManageMembers),
+		"manage_resources": types.BoolValue(project.AdminPrivileges.ManageResources),
+		"index_resources":  types.BoolValue(project.AdminPrivileges.IndexResources),
+	})
+	resp.Diagnostics.Append(ds...)
+	data.AdminPrivileges = adminPrivileges
+
+	data.Members = types.SetNull(types.ObjectType{AttrTypes: memberAttrTypes})
+	if data.IncludeMembers.ValueBool() {
+		data.Members, ds = memberAPIModelsToResourceSet(ctx, details[0].Users)
+		resp.Diagnostics.Append(ds...)
+	}
+
+	data.Groups = types.SetNull(types.ObjectType{AttrTypes: memberAttrTypes})
+	if data.IncludeGroups.ValueBool() {
+		data.Groups, ds = memberAPIModelsToResourceSet(ctx, details[0].Groups)
+		resp.Diagnostics.Append(ds...)
+	}
+
+	data.Repos = types.SetNull(types.StringType)
+	if data.IncludeRepositories.ValueBool() {
+		data.Repos, ds = types.SetValueFrom(ctx, types.StringType, details[0].Repos)
+		resp.Diagnostics.Append(ds...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threshold := int64(defaultQuotaWarningThreshold)
+	if !data.QuotaWarningThreshold.IsNull() {
+		threshold = data.QuotaWarningThreshold.ValueInt64()
+	}
+
+	quotaStatus, ds := checkQuotaUtilization(ctx, projectKey, project.StorageQuota, threshold, d.ProviderData.Client)
+	resp.Diagnostics.Append(ds...)
+	data.QuotaExceeded = quotaStatus.Exceeded
+	data.OverSoftLimit = quotaStatus.OverSoftLimit
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,161 @@
+package project
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const licenseUrl = "/artifactory/api/system/license"
+
+const (
+	accessTokenType    = "access"
+	referenceTokenType = "reference"
+)
+
+func NewProjectAccessDiagnosticsDataSource() datasource.DataSource {
+	return &ProjectAccessDiagnosticsDataSource{
+		TypeName: "project_access_diagnostics",
+	}
+}
+
+type ProjectAccessDiagnosticsDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectAccessDiagnosticsDataSourceModel struct {
+	Subject         types.String `tfsdk:"subject"`
+	TokenType       types.String `tfsdk:"token_type"`
+	Scopes          types.Set    `tfsdk:"scopes"`
+	PlatformVersion types.String `tfsdk:"platform_version"`
+	LicenseType     types.String `tfsdk:"license_type"`
+}
+
+type LicenseAPIModel struct {
+	Type string `json:"type"`
+}
+
+type accessTokenClaims struct {
+	Subject string `json:"sub"`
+	Scope   string `json:"scp"`
+}
+
+// parseAccessToken reads the claims of a JWT access token without verifying its signature,
+// which is left to the platform. Reference tokens aren't JWTs and have no readable claims.
+func parseAccessToken(token string) (*accessTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode access token payload: %w", err)
+	}
+
+	var claims accessTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to parse access token claims: %w", err)
+	}
+
+	return &claims, nil
+}
+
+func (d *ProjectAccessDiagnosticsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectAccessDiagnosticsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subject": schema.StringAttribute{
+				Computed:    true,
+				Description: "Subject of the access token used by the provider, e.g. `jfrt@01abc/users/admin`. Null for reference tokens.",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Type of the token used by the provider: `%s` for JWT access tokens or `%s` for reference tokens.", accessTokenType, referenceTokenType),
+			},
+			"scopes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Scopes of the access token used by the provider, e.g. `applied-permissions/admin`. Null for reference tokens.",
+			},
+			"platform_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of Artifactory on the JFrog Platform.",
+			},
+			"license_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the Artifactory license, e.g. `Enterprise Plus`. Null with a warning when the license can't be read with the provider credentials.",
+			},
+		},
+		Description: "Returns diagnostics about the credentials and platform the provider is configured with, so configurations can assert preconditions (e.g. an Enterprise+ license and an admin token) before attempting changes.",
+	}
+}
+
+func (d *ProjectAccessDiagnosticsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectAccessDiagnosticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	data := ProjectAccessDiagnosticsDataSourceModel{
+		Subject:         types.StringNull(),
+		TokenType:       types.StringValue(referenceTokenType),
+		Scopes:          types.SetNull(types.StringType),
+		PlatformVersion: types.StringValue(d.ProviderData.ArtifactoryVersion),
+		LicenseType:     types.StringNull(),
+	}
+
+	claims, err := parseAccessToken(d.ProviderData.Client.Token)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	if claims != nil {
+		scopes, ds := types.SetValueFrom(ctx, types.StringType, strings.Fields(claims.Scope))
+		resp.Diagnostics.Append(ds...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Subject = types.StringValue(claims.Subject)
+		data.TokenType = types.StringValue(accessTokenType)
+		data.Scopes = scopes
+	}
+
+	var license LicenseAPIModel
+	response, err := d.ProviderData.Client.R().
+		SetResult(&license).
+		Get(licenseUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		resp.Diagnostics.AddWarning(
+			"Unable to read license",
+			fmt.Sprintf("%s returned %d, license_type is not set. Reading the license may require an admin token.", licenseUrl, response.StatusCode()),
+		)
+	} else {
+		data.LicenseType = types.StringValue(license.Type)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
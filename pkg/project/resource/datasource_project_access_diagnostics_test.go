@@ -0,0 +1,25 @@
+package project_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+)
+
+func TestAccProjectAccessDiagnosticsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "project_access_diagnostics" "current" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.project_access_diagnostics.current", "token_type"),
+					resource.TestCheckResourceAttrSet("data.project_access_diagnostics.current", "platform_version"),
+					resource.TestCheckResourceAttrSet("data.project_access_diagnostics.current", "license_type"),
+				),
+			},
+		},
+	})
+}
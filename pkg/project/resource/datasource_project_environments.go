@@ -0,0 +1,177 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectEnvironmentsDataSource() datasource.DataSource {
+	return &ProjectEnvironmentsDataSource{
+		TypeName: "project_environments",
+	}
+}
+
+type ProjectEnvironmentsDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectEnvironmentsDataSourceModel struct {
+	ProjectKey   types.String              `tfsdk:"project_key"`
+	Environments []ProjectEnvironmentModel `tfsdk:"environments"`
+}
+
+type ProjectEnvironmentModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+	Name       types.String `tfsdk:"name"`
+	FullName   types.String `tfsdk:"full_name"`
+	Global     types.Bool   `tfsdk:"global"`
+}
+
+func (d *ProjectEnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectEnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "Only list the environments of this project, including the global environments available to it, e.g. to validate the `environments` of `project_role`. Doesn't require the 'Administer the Platform' role.",
+			},
+			"environments": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Key of the project the environment belongs to.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the environment without the project key prefix, as set in `project_environment`.",
+						},
+						"full_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the environment as stored by the platform, i.e. `<project_key>-<name>` for custom environments.",
+						},
+						"global": schema.BoolAttribute{
+							Computed:    true,
+							Description: "`true` for global environments, e.g. `DEV` and `PROD`, whose `name` and `full_name` are the same.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Custom environments of every project, sorted by project key and name. Global environments, e.g. `DEV` and `PROD`, are only included, first, when `project_key` is set.",
+			},
+		},
+		Description: "Lists the custom environments of every project, e.g. to detect non-standard environment names across the platform, or all the environments of one project. Listing every project requires a user assigned with the 'Administer the Platform' role.",
+	}
+}
+
+func (d *ProjectEnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectEnvironmentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKeys := []string{data.ProjectKey.ValueString()}
+	if data.ProjectKey.IsNull() {
+		var projects []ProjectAPIModel
+		var projectError ProjectErrorsResponse
+		response, err := d.ProviderData.Client.R().
+			SetResult(&projects).
+			SetError(&projectError).
+			Get(ProjectsUrl)
+		if err != nil {
+			UnableToReadDataSourceError(resp, err.Error())
+			return
+		}
+		if response.IsError() {
+			UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+			return
+		}
+
+		projectKeys = lo.Map(projects, func(project ProjectAPIModel, _ int) string {
+			return project.Key
+		})
+		sort.Strings(projectKeys)
+	}
+
+	data.Environments = []ProjectEnvironmentModel{}
+	for _, projectKey := range projectKeys {
+		var environments []ProjectEnvironmentAPIModel
+		var projectError ProjectErrorsResponse
+		response, err := d.ProviderData.Client.R().
+			SetPathParam("projectKey", projectKey).
+			SetResult(&environments).
+			SetError(&projectError).
+			Get(ProjectEnvironmentUrl)
+		if err != nil {
+			UnableToReadDataSourceError(resp, err.Error())
+			return
+		}
+		if response.IsError() {
+			UnableToReadDataSourceError(resp, fmt.Sprintf("failed to list environments of project '%s': %s", projectKey, apiErrorMessage(response, projectError)))
+			return
+		}
+
+		// The list includes the global environments, custom ones are prefixed with the project key
+		prefix := fmt.Sprintf("%s-", projectKey)
+		var globalNames, names []string
+		for _, env := range environments {
+			if strings.HasPrefix(env.Name, prefix) {
+				names = append(names, env.Name)
+			} else {
+				globalNames = append(globalNames, env.Name)
+			}
+		}
+		sort.Strings(globalNames)
+		sort.Strings(names)
+
+		if !data.ProjectKey.IsNull() {
+			for _, name := range globalNames {
+				data.Environments = append(data.Environments, ProjectEnvironmentModel{
+					ProjectKey: types.StringValue(projectKey),
+					Name:       types.StringValue(name),
+					FullName:   types.StringValue(name),
+					Global:     types.BoolValue(true),
+				})
+			}
+		}
+
+		for _, name := range names {
+			data.Environments = append(data.Environments, ProjectEnvironmentModel{
+				ProjectKey: types.StringValue(projectKey),
+				Name:       types.StringValue(strings.TrimPrefix(name, prefix)),
+				FullName:   types.StringValue(name),
+				Global:     types.BoolValue(false),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,120 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectEnvironmentsDataSource_full(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectEnvironmentsDataSource", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_environment" "{{ .name }}" {
+			name = "staging"
+			project_key = project.{{ .name }}.key
+		}
+
+		data "project_environments" "{{ .name }}" {
+			depends_on = [project_environment.{{ .name }}]
+		}
+	`, params)
+
+	fqrn := fmt.Sprintf("data.project_environments.%s", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "environments.*", map[string]string{
+						"project_key": projectKey,
+						"name":        "staging",
+						"full_name":   fmt.Sprintf("%s-staging", projectKey),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectEnvironmentsDataSource_projectKey(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectEnvironmentsDataSource", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_environment" "{{ .name }}" {
+			name = "staging"
+			project_key = project.{{ .name }}.key
+		}
+
+		data "project_environments" "{{ .name }}" {
+			project_key = project.{{ .name }}.key
+			depends_on  = [project_environment.{{ .name }}]
+		}
+	`, params)
+
+	fqrn := fmt.Sprintf("data.project_environments.%s", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "project_key", projectKey),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "environments.*", map[string]string{
+						"project_key": projectKey,
+						"name":        "DEV",
+						"full_name":   "DEV",
+						"global":      "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "environments.*", map[string]string{
+						"project_key": projectKey,
+						"name":        "staging",
+						"full_name":   fmt.Sprintf("%s-staging", projectKey),
+						"global":      "false",
+					}),
+				),
+			},
+		},
+	})
+}
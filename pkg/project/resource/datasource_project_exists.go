@@ -0,0 +1,91 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+func NewProjectExistsDataSource() datasource.DataSource {
+	return &ProjectExistsDataSource{
+		TypeName: "project_exists",
+	}
+}
+
+type ProjectExistsDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectExistsDataSourceModel struct {
+	Key    types.String `tfsdk:"key"`
+	Exists types.Bool   `tfsdk:"exists"`
+}
+
+func (d *ProjectExistsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectExistsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project to look up.",
+			},
+			"exists": schema.BoolAttribute{
+				Computed:    true,
+				Description: "`true` if the project exists, `false` otherwise.",
+			},
+		},
+		Description: "Checks whether a project exists without failing when it doesn't. Useful in conditional expressions and `precondition` blocks.",
+	}
+}
+
+func (d *ProjectExistsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectExistsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectExistsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.ProviderData.Client.R().
+		SetPathParam("projectKey", data.Key.ValueString()).
+		Head(ProjectUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	switch {
+	case response.StatusCode() == http.StatusNotFound:
+		data.Exists = types.BoolValue(false)
+	case response.IsError():
+		UnableToReadDataSourceError(resp, fmt.Sprintf("unexpected status %d when checking project '%s'", response.StatusCode(), data.Key.ValueString()))
+		return
+	default:
+		data.Exists = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
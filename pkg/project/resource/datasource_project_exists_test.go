@@ -0,0 +1,57 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectExistsDataSource(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+		"missing_key": strings.ToLower(acctest.RandSeq(10)),
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectExistsDataSource", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		data "project_exists" "existing" {
+			key = project.{{ .name }}.key
+		}
+
+		data "project_exists" "missing" {
+			key = "{{ .missing_key }}"
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.project_exists.existing", "key", projectKey),
+					resource.TestCheckResourceAttr("data.project_exists.existing", "exists", "true"),
+					resource.TestCheckResourceAttr("data.project_exists.missing", "exists", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package project
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+func NewProjectGroupDataSource() datasource.DataSource {
+	return &ProjectGroupDataSource{
+		TypeName: "project_group",
+	}
+}
+
+type ProjectGroupDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectGroupDataSourceModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+	Name       types.String `tfsdk:"name"`
+	IsMember   types.Bool   `tfsdk:"is_member"`
+	Roles      types.Set    `tfsdk:"roles"`
+}
+
+func (d *ProjectGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project to look up the group in.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the group.",
+			},
+			"is_member": schema.BoolAttribute{
+				Computed:    true,
+				Description: "`true` if the group is a member of the project, `false` otherwise.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Roles of the group in the project. Empty when the group isn't a member.",
+			},
+		},
+		Description: "Returns the roles a group has in a project, e.g. to assert in policy checks that no group holds the 'Project Admin' role. Doesn't fail when the group isn't a member of the project.",
+	}
+}
+
+func (d *ProjectGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectGroupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := readMember(ctx, data.ProjectKey.ValueString(), groupsMembershipType, data.Name.ValueString(), d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	roles := []string{}
+	if group != nil {
+		roles = group.Roles
+	}
+
+	data.IsMember = types.BoolValue(group != nil)
+	rolesSet, ds := types.SetValueFrom(ctx, types.StringType, roles)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Roles = rolesSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
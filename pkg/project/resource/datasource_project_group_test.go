@@ -0,0 +1,83 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectGroupDataSource(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, _, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  strings.ToLower(acctest.RandSeq(10)),
+		"group":        groupName,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectGroupDataSource", `
+		resource "artifactory_group" "{{ .group }}" {
+			name = "{{ .group }}"
+		}
+
+		resource "artifactory_group" "{{ .group }}-other" {
+			name = "{{ .group }}-other"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_group.{{ .group }}.name
+			roles = ["Developer", "Viewer"]
+		}
+
+		data "project_group" "member" {
+			project_key = project_group.{{ .group }}.project_key
+			name = project_group.{{ .group }}.name
+		}
+
+		data "project_group" "not_member" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_group.{{ .group }}-other.name
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.project_group.member", "is_member", "true"),
+					resource.TestCheckResourceAttr("data.project_group.member", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.project_group.member", "roles.*", "Developer"),
+					resource.TestCheckTypeSetElemAttr("data.project_group.member", "roles.*", "Viewer"),
+					resource.TestCheckResourceAttr("data.project_group.not_member", "is_member", "false"),
+					resource.TestCheckResourceAttr("data.project_group.not_member", "roles.#", "0"),
+					resource.TestCheckResourceAttr("data.project_group.not_member", "name", fmt.Sprintf("%s-other", groupName)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectMembershipDataSource() datasource.DataSource {
+	return &ProjectMembershipDataSource{
+		TypeName: "project_membership",
+	}
+}
+
+type ProjectMembershipDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectMembershipDataSourceModel struct {
+	ProjectKey     types.String `tfsdk:"project_key"`
+	MembershipJSON types.String `tfsdk:"membership_json"`
+}
+
+// NormalizedMembership is the document exposed as `membership_json`. Field order is fixed by
+// the struct so the JSON output is stable.
+type NormalizedMembership struct {
+	ProjectKey string           `json:"project_key"`
+	Users      []MemberAPIModel `json:"users"`
+	Groups     []MemberAPIModel `json:"groups"`
+}
+
+// normalizeMembers lowercases member names and roles, removes duplicate roles, and sorts both
+// members and roles so the output doesn't change with API ordering or casing.
+func normalizeMembers(members []MemberAPIModel) []MemberAPIModel {
+	normalized := lo.Map(members, func(member MemberAPIModel, _ int) MemberAPIModel {
+		roles := lo.Uniq(lo.Map(member.Roles, func(role string, _ int) string {
+			return strings.ToLower(role)
+		}))
+		sort.Strings(roles)
+
+		return MemberAPIModel{
+			Name:  strings.ToLower(member.Name),
+			Roles: roles,
+		}
+	})
+
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].Name < normalized[j].Name
+	})
+
+	return normalized
+}
+
+func (d *ProjectMembershipDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectMembershipDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project.",
+			},
+			"membership_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON document with the `project_key` and the `users` and `groups` members of the project, each with `name` and `roles`. Names and roles are lowercased and sorted so the document is stable between runs.",
+			},
+		},
+		Description: "Returns the user and group memberships of a project as normalized JSON, designed to be fed into policy engines such as OPA or Sentinel to validate access rules during plan.",
+	}
+}
+
+func (d *ProjectMembershipDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectMembershipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectMembershipDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := data.ProjectKey.ValueString()
+
+	users, err := readMembers(ctx, projectKey, usersMembershipType, d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	groups, err := readMembers(ctx, projectKey, groupsMembershipType, d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	membership, err := json.Marshal(NormalizedMembership{
+		ProjectKey: projectKey,
+		Users:      normalizeMembers(users),
+		Groups:     normalizeMembers(groups),
+	})
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	data.MembershipJSON = types.StringValue(string(membership))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectMembershipDataSource(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	_, _, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+		"group":       groupName,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectMembershipDataSource", `
+		resource "artifactory_group" "{{ .group }}" {
+			name = "{{ .group }}"
+		}
+
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .name }}.key
+			name = artifactory_group.{{ .group }}.name
+			roles = ["Project Admin", "Developer"]
+		}
+
+		data "project_membership" "{{ .name }}" {
+			project_key = project_group.{{ .group }}.project_key
+		}
+	`, params)
+
+	expected := fmt.Sprintf(`{"project_key":"%s","users":[],"groups":[{"name":"%s","roles":["developer","project admin"]}]}`, projectKey, groupName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.project_membership.%s", name), "membership_json", expected),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+package project
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectReleaseBundlesDataSource() datasource.DataSource {
+	return &ProjectReleaseBundlesDataSource{
+		TypeName: "project_release_bundles",
+	}
+}
+
+type ProjectReleaseBundlesDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectReleaseBundlesDataSourceModel struct {
+	ProjectKey     types.String                `tfsdk:"project_key"`
+	ReleaseBundles []ProjectReleaseBundleModel `tfsdk:"release_bundles"`
+	Repositories   types.Set                   `tfsdk:"repositories"`
+}
+
+type ProjectReleaseBundleModel struct {
+	Name          types.String `tfsdk:"name"`
+	ProjectKey    types.String `tfsdk:"project_key"`
+	RepositoryKey types.String `tfsdk:"repository_key"`
+	LatestVersion types.String `tfsdk:"latest_version"`
+}
+
+func (d *ProjectReleaseBundlesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectReleaseBundlesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project.",
+			},
+			"release_bundles": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the release bundle.",
+						},
+						"project_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Key of the project the release bundle is scoped to.",
+						},
+						"repository_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Key of the release bundle repository storing the release bundle.",
+						},
+						"latest_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Latest version of the release bundle.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Release Bundles v2 of the project, sorted by name. Empty when the Lifecycle service isn't available.",
+			},
+			"repositories": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the release bundle repositories storing the release bundles of the project.",
+			},
+		},
+		Description: "Lists the Release Bundles v2 associated with a project and the release bundle repositories storing them, e.g. to verify project scoping before distribution.",
+	}
+}
+
+func (d *ProjectReleaseBundlesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectReleaseBundlesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectReleaseBundlesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	releaseBundles, err := readReleaseBundles(ctx, data.ProjectKey.ValueString(), d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	sort.Slice(releaseBundles, func(i, j int) bool {
+		return releaseBundles[i].Name < releaseBundles[j].Name
+	})
+
+	data.ReleaseBundles = lo.Map(releaseBundles, func(rb ReleaseBundleAPIModel, _ int) ProjectReleaseBundleModel {
+		return ProjectReleaseBundleModel{
+			Name:          types.StringValue(rb.Name),
+			ProjectKey:    types.StringValue(rb.ProjectKey),
+			RepositoryKey: types.StringValue(rb.RepositoryKey),
+			LatestVersion: types.StringValue(rb.LatestVersion),
+		}
+	})
+
+	repositories, ds := types.SetValueFrom(ctx, types.StringType, lo.Uniq(lo.Map(releaseBundles, func(rb ReleaseBundleAPIModel, _ int) string {
+		return rb.RepositoryKey
+	})))
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Repositories = repositories
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
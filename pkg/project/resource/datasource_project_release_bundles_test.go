@@ -0,0 +1,54 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectReleaseBundlesDataSource_empty(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectReleaseBundlesDataSource", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		data "project_release_bundles" "{{ .name }}" {
+			project_key = project.{{ .name }}.key
+		}
+	`, params)
+
+	fqrn := fmt.Sprintf("data.project_release_bundles.%s", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "project_key", projectKey),
+					resource.TestCheckResourceAttr(fqrn, "release_bundles.#", "0"),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package project
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+var repositoryClasses = []string{"local", "remote", "virtual", "federated"}
+
+func NewProjectRepositoriesDataSource() datasource.DataSource {
+	return &ProjectRepositoriesDataSource{
+		TypeName: "project_repositories",
+	}
+}
+
+type ProjectRepositoriesDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectRepositoriesDataSourceModel struct {
+	ProjectKey   types.String                   `tfsdk:"project_key"`
+	PackageType  types.String                   `tfsdk:"package_type"`
+	Rclass       types.String                   `tfsdk:"rclass"`
+	Keys         []types.String                 `tfsdk:"keys"`
+	Repositories []ProjectRepositoriesRepoModel `tfsdk:"repositories"`
+}
+
+type ProjectRepositoriesRepoModel struct {
+	Key         types.String `tfsdk:"key"`
+	PackageType types.String `tfsdk:"package_type"`
+	Rclass      types.String `tfsdk:"rclass"`
+}
+
+func (d *ProjectRepositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectRepositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project.",
+			},
+			"package_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Only include repositories of this package type, e.g. `maven` or `docker`. Case insensitive.",
+			},
+			"rclass": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(repositoryClasses...),
+				},
+				Description: "Only include repositories of this class (" + strings.Join(repositoryClasses, ", ") + ").",
+			},
+			"keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the matching repositories, sorted. Convenient for `for_each` with `toset()`.",
+			},
+			"repositories": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Key of the repository.",
+						},
+						"package_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Package type of the repository, in lower case.",
+						},
+						"rclass": schema.StringAttribute{
+							Computed:    true,
+							Description: "Class of the repository, in lower case.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Matching repositories assigned to the project, sorted by key.",
+			},
+		},
+		Description: "Lists the repositories assigned to a project, optionally filtered by package type and class, e.g. to iterate over them with `for_each` in downstream modules.",
+	}
+}
+
+func (d *ProjectRepositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectRepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectRepositoriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var repos []RepositoryListAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetQueryParam("project", data.ProjectKey.ValueString()).
+		SetResult(&repos).
+		SetError(&projectError).
+		Get(projectRepositoriesUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Key < repos[j].Key
+	})
+
+	data.Keys = []types.String{}
+	data.Repositories = []ProjectRepositoriesRepoModel{}
+	for _, repo := range repos {
+		if !data.PackageType.IsNull() && !strings.EqualFold(repo.PackageType, data.PackageType.ValueString()) {
+			continue
+		}
+		if !data.Rclass.IsNull() && !strings.EqualFold(repo.Type, data.Rclass.ValueString()) {
+			continue
+		}
+
+		data.Keys = append(data.Keys, types.StringValue(repo.Key))
+		data.Repositories = append(data.Repositories, ProjectRepositoriesRepoModel{
+			Key:         types.StringValue(repo.Key),
+			PackageType: types.StringValue(strings.ToLower(repo.PackageType)),
+			Rclass:      types.StringValue(strings.ToLower(repo.Type)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
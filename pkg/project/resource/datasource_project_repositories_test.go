@@ -0,0 +1,87 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectRepositoriesDataSource(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"repo_key":     repoKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectRepositoriesDataSource", `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key"]
+			}
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_repository" "{{ .repo_key }}" {
+			project_key = project.{{ .project_name }}.key
+			key         = artifactory_local_generic_repository.{{ .repo_key }}.key
+		}
+
+		data "project_repositories" "all" {
+			project_key = project_repository.{{ .repo_key }}.project_key
+		}
+
+		data "project_repositories" "local_generic" {
+			project_key  = project_repository.{{ .repo_key }}.project_key
+			package_type = "Generic"
+			rclass       = "local"
+		}
+
+		data "project_repositories" "remote" {
+			project_key = project_repository.{{ .repo_key }}.project_key
+			rclass      = "remote"
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.project_repositories.all", "keys.*", repoKey),
+					resource.TestCheckResourceAttr("data.project_repositories.local_generic", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.project_repositories.local_generic", "repositories.0.key", repoKey),
+					resource.TestCheckResourceAttr("data.project_repositories.local_generic", "repositories.0.package_type", "generic"),
+					resource.TestCheckResourceAttr("data.project_repositories.local_generic", "repositories.0.rclass", "local"),
+					resource.TestCheckResourceAttr("data.project_repositories.remote", "keys.#", "0"),
+				),
+			},
+		},
+	})
+}
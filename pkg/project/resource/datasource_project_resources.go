@@ -0,0 +1,139 @@
+package project
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectResourcesDataSource() datasource.DataSource {
+	return &ProjectResourcesDataSource{
+		TypeName: "project_resources",
+	}
+}
+
+type ProjectResourcesDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectResourcesDataSourceModel struct {
+	ProjectKey      types.String `tfsdk:"project_key"`
+	Repositories    types.Set    `tfsdk:"repositories"`
+	Builds          types.Set    `tfsdk:"builds"`
+	ReleaseBundles  types.Set    `tfsdk:"release_bundles"`
+	PipelineSources types.Set    `tfsdk:"pipeline_sources"`
+}
+
+func (d *ProjectResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project.",
+			},
+			"repositories": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the repositories assigned to the project.",
+			},
+			"builds": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Names of the builds in the project.",
+			},
+			"release_bundles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Names of the Release Bundles v2 in the project. Empty when the Lifecycle service isn't available.",
+			},
+			"pipeline_sources": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Pipeline sources of the project in the format `repository:branch`. Empty when Pipelines isn't installed.",
+			},
+		},
+		Description: "Returns the resources scoped to a project, mirroring the Resources tab of the project in the JFrog Platform UI. Useful for inventory and migration tooling.",
+	}
+}
+
+func (d *ProjectResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := data.ProjectKey.ValueString()
+
+	repos, err := readRepos(ctx, projectKey, d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	builds, err := readBuilds(ctx, projectKey, d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	releaseBundles, err := readReleaseBundles(ctx, projectKey, d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	pipelineSources, err := readPipelineSources(ctx, projectKey, d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	repositories, ds := types.SetValueFrom(ctx, types.StringType, lo.Ternary(repos == nil, []string{}, repos))
+	resp.Diagnostics.Append(ds...)
+	data.Repositories = repositories
+
+	buildNames, ds := types.SetValueFrom(ctx, types.StringType, builds)
+	resp.Diagnostics.Append(ds...)
+	data.Builds = buildNames
+
+	releaseBundleNames, ds := types.SetValueFrom(ctx, types.StringType, lo.Map(releaseBundles, func(rb ReleaseBundleAPIModel, _ int) string {
+		return rb.Name
+	}))
+	resp.Diagnostics.Append(ds...)
+	data.ReleaseBundles = releaseBundleNames
+
+	sources, ds := types.SetValueFrom(ctx, types.StringType, pipelineSources)
+	resp.Diagnostics.Append(ds...)
+	data.PipelineSources = sources
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
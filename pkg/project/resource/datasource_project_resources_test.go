@@ -0,0 +1,76 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectResourcesDataSource(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+		"repo_key":    repoKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectResourcesDataSource", `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key"]
+			}
+		}
+
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_repository" "{{ .repo_key }}" {
+			project_key = project.{{ .name }}.key
+			key         = artifactory_local_generic_repository.{{ .repo_key }}.key
+		}
+
+		data "project_resources" "{{ .name }}" {
+			project_key = project_repository.{{ .repo_key }}.project_key
+		}
+	`, params)
+
+	fqrn := fmt.Sprintf("data.project_resources.%s", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "project_key", projectKey),
+					resource.TestCheckResourceAttr(fqrn, "repositories.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "repositories.*", repoKey),
+					resource.TestCheckResourceAttr(fqrn, "builds.#", "0"),
+				),
+			},
+		},
+	})
+}
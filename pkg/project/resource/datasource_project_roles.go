@@ -0,0 +1,171 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+const predefinedRoleType = "PREDEFINED"
+
+func NewProjectRolesDataSource() datasource.DataSource {
+	return &ProjectRolesDataSource{
+		TypeName: "project_roles",
+	}
+}
+
+type ProjectRolesDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectRolesDataSourceModel struct {
+	ProjectKey types.String            `tfsdk:"project_key"`
+	Type       types.String            `tfsdk:"type"`
+	Roles      []ProjectRolesRoleModel `tfsdk:"roles"`
+}
+
+type ProjectRolesRoleModel struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Type         types.String `tfsdk:"type"`
+	Environments types.Set    `tfsdk:"environments"`
+	Actions      types.Set    `tfsdk:"actions"`
+}
+
+func (d *ProjectRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project.",
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(predefinedRoleType, customRoleType),
+				},
+				Description: fmt.Sprintf("Only include roles of this type (%s, %s). All roles are included when not set.", predefinedRoleType, customRoleType),
+			},
+			"roles": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the role, as used in the `roles` of `project_user` and `project_group`.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the role.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Type of the role, `%s` or `%s`.", predefinedRoleType, customRoleType),
+						},
+						"environments": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Environments the role applies to.",
+						},
+						"actions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Actions allowed by the role.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Roles of the project, sorted by name.",
+			},
+		},
+		Description: "Lists the predefined and custom roles of a project, e.g. to validate the roles used in `project_user` and `project_group`.",
+	}
+}
+
+func (d *ProjectRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectRolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := data.ProjectKey.ValueString()
+
+	var roles []Role
+	var projectError ProjectErrorsResponse
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("projectKey", projectKey).
+		SetResult(&roles).
+		SetError(&projectError).
+		Get(ProjectRolesUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		UnableToReadDataSourceError(resp, fmt.Sprintf("project '%s' not found", projectKey))
+		return
+	}
+	if response.IsError() {
+		UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	if !data.Type.IsNull() {
+		roles = filterRoles(roles, data.Type.ValueString())
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	data.Roles = []ProjectRolesRoleModel{}
+	for _, role := range roles {
+		environments, ds := types.SetValueFrom(ctx, types.StringType, role.Environments)
+		resp.Diagnostics.Append(ds...)
+
+		actions, ds := types.SetValueFrom(ctx, types.StringType, role.Actions)
+		resp.Diagnostics.Append(ds...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Roles = append(data.Roles, ProjectRolesRoleModel{
+			Name:         types.StringValue(role.Name),
+			Description:  types.StringValue(role.Description),
+			Type:         types.StringValue(role.Type),
+			Environments: environments,
+			Actions:      actions,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
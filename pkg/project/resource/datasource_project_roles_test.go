@@ -0,0 +1,76 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectRolesDataSource(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	roleName := fmt.Sprintf("tftestrole%s", acctest.RandSeq(6))
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+		"role_name":   roleName,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectRolesDataSource", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_role" "{{ .role_name }}" {
+			name = "{{ .role_name }}"
+			type = "CUSTOM"
+			project_key = project.{{ .name }}.key
+			environments = ["DEV"]
+			actions = ["READ_REPOSITORY"]
+		}
+
+		data "project_roles" "all" {
+			project_key = project_role.{{ .role_name }}.project_key
+		}
+
+		data "project_roles" "custom" {
+			project_key = project_role.{{ .role_name }}.project_key
+			type = "CUSTOM"
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.project_roles.all", "roles.*", map[string]string{
+						"name": "Developer",
+						"type": "PREDEFINED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.project_roles.all", "roles.*", map[string]string{
+						"name": roleName,
+						"type": "CUSTOM",
+					}),
+					resource.TestCheckResourceAttr("data.project_roles.custom", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.project_roles.custom", "roles.0.name", roleName),
+					resource.TestCheckTypeSetElemAttr("data.project_roles.custom", "roles.0.actions.*", "READ_REPOSITORY"),
+					resource.TestCheckTypeSetElemAttr("data.project_roles.custom", "roles.0.environments.*", "DEV"),
+				),
+			},
+		},
+	})
+}
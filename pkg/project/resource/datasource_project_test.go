@@ -0,0 +1,81 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectDataSource", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = false
+				index_resources = true
+			}
+			max_storage_in_gibibytes = 2
+			block_deployments_on_limit = true
+			email_notification = false
+		}
+
+		data "project" "{{ .name }}" {
+			key = project.{{ .name }}.key
+			include_members = true
+			include_groups = true
+			include_repositories = true
+		}
+
+		data "project" "{{ .name }}_lazy" {
+			key = project.{{ .name }}.key
+		}
+	`, params)
+
+	dataSourceName := fmt.Sprintf("data.project.%s", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "key", projectKey),
+					resource.TestCheckResourceAttr(dataSourceName, "display_name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(dataSourceName, "admin_privileges.manage_members", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "admin_privileges.manage_resources", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "admin_privileges.index_resources", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_storage_in_gibibytes", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "block_deployments_on_limit", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "enforce_quota_block_deployments", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "email_notification", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "repos.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "members.#"),
+					resource.TestCheckResourceAttr(dataSourceName+"_lazy", "key", projectKey),
+					resource.TestCheckNoResourceAttr(dataSourceName+"_lazy", "members"),
+					resource.TestCheckNoResourceAttr(dataSourceName+"_lazy", "groups"),
+					resource.TestCheckNoResourceAttr(dataSourceName+"_lazy", "repos"),
+					resource.TestCheckResourceAttr(dataSourceName, "quota_exceeded", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "over_soft_limit", "false"),
+				),
+			},
+		},
+	})
+}
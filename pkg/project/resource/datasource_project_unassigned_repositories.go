@@ -0,0 +1,190 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/sync/errgroup"
+)
+
+// Number of projects whose repositories are read at once
+const projectRepositoriesRequestsConcurrency = 8
+
+func NewProjectUnassignedRepositoriesDataSource() datasource.DataSource {
+	return &ProjectUnassignedRepositoriesDataSource{
+		TypeName: "project_unassigned_repositories",
+	}
+}
+
+type ProjectUnassignedRepositoriesDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectUnassignedRepositoriesDataSourceModel struct {
+	PackageType  types.String                   `tfsdk:"package_type"`
+	Rclass       types.String                   `tfsdk:"rclass"`
+	Keys         []types.String                 `tfsdk:"keys"`
+	Repositories []ProjectRepositoriesRepoModel `tfsdk:"repositories"`
+}
+
+func (d *ProjectUnassignedRepositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectUnassignedRepositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"package_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Only include repositories of this package type, e.g. `maven` or `docker`. Case insensitive.",
+			},
+			"rclass": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(repositoryClasses...),
+				},
+				Description: "Only include repositories of this class (" + strings.Join(repositoryClasses, ", ") + ").",
+			},
+			"keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the matching repositories, sorted. Convenient for `contains()` in preconditions.",
+			},
+			"repositories": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Key of the repository.",
+						},
+						"package_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Package type of the repository, in lower case.",
+						},
+						"rclass": schema.StringAttribute{
+							Computed:    true,
+							Description: "Class of the repository, in lower case.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Matching repositories not assigned to any project, sorted by key.",
+			},
+		},
+		Description: "Lists the repositories not assigned to any project, optionally filtered by package type and class, e.g. to check in a precondition that a repository can be assigned with `project_repository` before the API rejects it. Reads the repositories of every project visible to the access token, so the token must be able to list all projects for the result to be accurate.",
+	}
+}
+
+func (d *ProjectUnassignedRepositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectUnassignedRepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectUnassignedRepositoriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var repos []RepositoryListAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetResult(&repos).
+		SetError(&projectError).
+		Get(projectRepositoriesUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	var projects []ProjectAPIModel
+	response, err = d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetResult(&projects).
+		SetError(&projectError).
+		Get(ProjectsUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	// The repositories list doesn't include the project, so each project is read
+	var mu sync.Mutex
+	assignedRepoKeys := map[string]bool{}
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(projectRepositoriesRequestsConcurrency)
+	for _, project := range projects {
+		g.Go(func() error {
+			repoKeys, err := readRepos(gctx, project.Key, d.ProviderData.Client)
+			if err != nil {
+				return fmt.Errorf("failed to read repositories of project %s: %s", project.Key, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, repoKey := range repoKeys {
+				assignedRepoKeys[repoKey] = true
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Key < repos[j].Key
+	})
+
+	data.Keys = []types.String{}
+	data.Repositories = []ProjectRepositoriesRepoModel{}
+	for _, repo := range repos {
+		if assignedRepoKeys[repo.Key] {
+			continue
+		}
+		if !data.PackageType.IsNull() && !strings.EqualFold(repo.PackageType, data.PackageType.ValueString()) {
+			continue
+		}
+		if !data.Rclass.IsNull() && !strings.EqualFold(repo.Type, data.Rclass.ValueString()) {
+			continue
+		}
+
+		data.Keys = append(data.Keys, types.StringValue(repo.Key))
+		data.Repositories = append(data.Repositories, ProjectRepositoriesRepoModel{
+			Key:         types.StringValue(repo.Key),
+			PackageType: types.StringValue(strings.ToLower(repo.PackageType)),
+			Rclass:      types.StringValue(strings.ToLower(repo.Type)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectUnassignedRepositoriesDataSource(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	assignedRepoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+	unassignedRepoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	params := map[string]interface{}{
+		"project_name":        projectName,
+		"project_key":         projectKey,
+		"assigned_repo_key":   assignedRepoKey,
+		"unassigned_repo_key": unassignedRepoKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectUnassignedRepositoriesDataSource", `
+		resource "artifactory_local_generic_repository" "{{ .assigned_repo_key }}" {
+			key = "{{ .assigned_repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key"]
+			}
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .unassigned_repo_key }}" {
+			key = "{{ .unassigned_repo_key }}"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_repository" "{{ .assigned_repo_key }}" {
+			project_key = project.{{ .project_name }}.key
+			key         = artifactory_local_generic_repository.{{ .assigned_repo_key }}.key
+		}
+
+		data "project_unassigned_repositories" "local_generic" {
+			package_type = "generic"
+			rclass       = "local"
+
+			depends_on = [
+				project_repository.{{ .assigned_repo_key }},
+				artifactory_local_generic_repository.{{ .unassigned_repo_key }},
+			]
+		}
+	`, params)
+
+	dataSourceName := "data.project_unassigned_repositories.local_generic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "keys.*", unassignedRepoKey),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[dataSourceName].Primary.Attributes
+						for key, value := range attrs {
+							if strings.HasPrefix(key, "keys.") && value == assignedRepoKey {
+								return fmt.Errorf("repository %s is assigned to project %s but listed as unassigned", assignedRepoKey, projectKey)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
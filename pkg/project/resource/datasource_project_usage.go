@@ -0,0 +1,120 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+func NewProjectUsageDataSource() datasource.DataSource {
+	return &ProjectUsageDataSource{
+		TypeName: "project_usage",
+	}
+}
+
+type ProjectUsageDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectUsageDataSourceModel struct {
+	ProjectKey      types.String  `tfsdk:"project_key"`
+	UsedBytes       types.Int64   `tfsdk:"used_bytes"`
+	QuotaBytes      types.Int64   `tfsdk:"quota_bytes"`
+	QuotaPercentage types.Float64 `tfsdk:"quota_percentage"`
+}
+
+func (d *ProjectUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project to report the storage usage of.",
+			},
+			"used_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Storage used by the repositories assigned to the project, in bytes.",
+			},
+			"quota_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Storage quota of the project, in bytes. `-1` when the storage is unlimited.",
+			},
+			"quota_percentage": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Percentage of the storage quota used by the project. Null when the storage is unlimited.",
+			},
+		},
+		Description: "Returns the storage used by a project and how much of its storage quota it represents, e.g. to build alerting or reporting on storage consumption. Artifactory calculates the storage summary periodically so the usage may lag behind recent deployments.",
+	}
+}
+
+func (d *ProjectUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := data.ProjectKey.ValueString()
+
+	var project ProjectAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("projectKey", projectKey).
+		SetResult(&project).
+		SetError(&projectError).
+		Get(ProjectUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		UnableToReadDataSourceError(resp, fmt.Sprintf("project '%s' not found", projectKey))
+		return
+	}
+	if response.IsError() {
+		UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	usage, err := readStorageUsage(ctx, projectKey, d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	data.UsedBytes = types.Int64Value(usage)
+	data.QuotaBytes = types.Int64Value(project.StorageQuota)
+	data.QuotaPercentage = types.Float64Null()
+	// -1 is unlimited storage
+	if project.StorageQuota > 0 {
+		data.QuotaPercentage = types.Float64Value(float64(usage) / float64(project.StorageQuota) * 100)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
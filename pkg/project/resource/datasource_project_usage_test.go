@@ -0,0 +1,52 @@
+package project_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectUsageDataSource(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  strings.ToLower(acctest.RandSeq(10)),
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectUsageDataSource", `
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			max_storage_in_gibibytes = 1
+		}
+
+		data "project_usage" "{{ .project_name }}" {
+			project_key = project.{{ .project_name }}.key
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.project_usage."+projectName, "used_bytes", "0"),
+					resource.TestCheckResourceAttr("data.project_usage."+projectName, "quota_bytes", "1073741824"),
+					resource.TestCheckResourceAttr("data.project_usage."+projectName, "quota_percentage", "0"),
+				),
+			},
+		},
+	})
+}
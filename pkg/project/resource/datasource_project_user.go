@@ -0,0 +1,105 @@
+package project
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+func NewProjectUserDataSource() datasource.DataSource {
+	return &ProjectUserDataSource{
+		TypeName: "project_user",
+	}
+}
+
+type ProjectUserDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectUserDataSourceModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+	Name       types.String `tfsdk:"name"`
+	IsMember   types.Bool   `tfsdk:"is_member"`
+	Roles      types.Set    `tfsdk:"roles"`
+}
+
+func (d *ProjectUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project to look up the user in.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the user.",
+			},
+			"is_member": schema.BoolAttribute{
+				Computed:    true,
+				Description: "`true` if the user is a member of the project, `false` otherwise.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Roles of the user in the project. Empty when the user isn't a member.",
+			},
+		},
+		Description: "Returns the roles a user has in a project, e.g. to only grant a break-glass role when the user isn't already a 'Project Admin'. Doesn't fail when the user isn't a member of the project.",
+	}
+}
+
+func (d *ProjectUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := readMember(ctx, data.ProjectKey.ValueString(), usersMembershipType, data.Name.ValueString(), d.ProviderData.Client)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	roles := []string{}
+	if user != nil {
+		roles = user.Roles
+	}
+
+	data.IsMember = types.BoolValue(user != nil)
+	rolesSet, ds := types.SetValueFrom(ctx, types.StringType, roles)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Roles = rolesSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
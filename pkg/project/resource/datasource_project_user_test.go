@@ -0,0 +1,89 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectUserDataSource(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, _, username := testutil.MkNames("test-project-user-", "project_user")
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  strings.ToLower(acctest.RandSeq(10)),
+		"username":     username,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectUserDataSource", `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .username }}@tempurl.org"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "artifactory_managed_user" "{{ .username }}-other" {
+			name     = "{{ .username }}-other"
+			email    = "{{ .username }}-other@tempurl.org"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = ["Developer", "Project Admin"]
+		}
+
+		data "project_user" "member" {
+			project_key = project_user.{{ .username }}.project_key
+			name = project_user.{{ .username }}.name
+		}
+
+		data "project_user" "not_member" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_managed_user.{{ .username }}-other.name
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.project_user.member", "is_member", "true"),
+					resource.TestCheckResourceAttr("data.project_user.member", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.project_user.member", "roles.*", "Developer"),
+					resource.TestCheckTypeSetElemAttr("data.project_user.member", "roles.*", "Project Admin"),
+					resource.TestCheckResourceAttr("data.project_user.not_member", "is_member", "false"),
+					resource.TestCheckResourceAttr("data.project_user.not_member", "roles.#", "0"),
+					resource.TestCheckResourceAttr("data.project_user.not_member", "name", fmt.Sprintf("%s-other", username)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,308 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/sync/errgroup"
+)
+
+// Number of requests for project details sent at once. The provider `max_concurrent_requests`
+// limit applies on top of it.
+const projectDetailsRequestsConcurrency = 8
+
+type projectDetails struct {
+	Users  []MemberAPIModel
+	Groups []MemberAPIModel
+	Repos  []string
+}
+
+// Which of the project details are read, the others are left empty
+type projectDetailsIncludes struct {
+	Users  bool
+	Groups bool
+	Repos  bool
+}
+
+var allProjectDetails = projectDetailsIncludes{Users: true, Groups: true, Repos: true}
+
+// readProjectDetails reads the included users, groups and repositories of the projects in
+// parallel, sending at most projectDetailsRequestsConcurrency requests at once. Details are
+// returned in the order of projectKeys.
+func readProjectDetails(ctx context.Context, projectKeys []string, includes projectDetailsIncludes, client *resty.Client) ([]projectDetails, error) {
+	details := make([]projectDetails, len(projectKeys))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(projectDetailsRequestsConcurrency)
+	for i, projectKey := range projectKeys {
+		if includes.Users {
+			g.Go(func() error {
+				users, err := readMembers(gctx, projectKey, usersMembershipType, client)
+				if err != nil {
+					return fmt.Errorf("failed to read users of project %s: %s", projectKey, err)
+				}
+				details[i].Users = users
+				return nil
+			})
+		}
+
+		if includes.Groups {
+			g.Go(func() error {
+				groups, err := readMembers(gctx, projectKey, groupsMembershipType, client)
+				if err != nil {
+					return fmt.Errorf("failed to read groups of project %s: %s", projectKey, err)
+				}
+				details[i].Groups = groups
+				return nil
+			})
+		}
+
+		if includes.Repos {
+			g.Go(func() error {
+				repos, err := readRepos(gctx, projectKey, client)
+				if err != nil {
+					return fmt.Errorf("failed to read repositories of project %s: %s", projectKey, err)
+				}
+				details[i].Repos = repos
+				return nil
+			})
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{
+		TypeName: "projects",
+	}
+}
+
+type ProjectsDataSource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectsDataSourceModel struct {
+	KeyPrefix        types.String           `tfsdk:"key_prefix"`
+	DisplayNameRegex types.String           `tfsdk:"display_name_regex"`
+	IncludeDetails   types.Bool             `tfsdk:"include_details"`
+	IncludeCounts    types.Bool             `tfsdk:"include_counts"`
+	Projects         []ProjectsProjectModel `tfsdk:"projects"`
+}
+
+type ProjectsProjectModel struct {
+	Key         types.String `tfsdk:"key"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	Members     types.Set    `tfsdk:"members"`
+	Groups      types.Set    `tfsdk:"groups"`
+	Repos       types.Set    `tfsdk:"repos"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+	GroupCount  types.Int64  `tfsdk:"group_count"`
+	RepoCount   types.Int64  `tfsdk:"repository_count"`
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects whose key starts with this prefix.",
+			},
+			"display_name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include projects whose display name matches this regular expression, e.g. `^team-`. Uses the [RE2 syntax](https://github.com/google/re2/wiki/Syntax).",
+			},
+			"include_details": schema.BoolAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("When set to `true`, the members, groups and repositories of every matching project are read too, up to `%d` requests at once. Default to `false`.", projectDetailsRequestsConcurrency),
+			},
+			"include_counts": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When set to `true`, the number of members, groups and repositories of every matching project is set, e.g. for reports. They are read the same way as with `include_details`, without adding the collections to the state. Default to `false`.",
+			},
+			"projects": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Key of the project.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the project.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the project.",
+						},
+						"members": schema.SetNestedAttribute{
+							NestedObject: memberNestedObject,
+							Computed:     true,
+							Description:  "Users who are members of the project. Only set when `include_details` is `true`.",
+						},
+						"groups": schema.SetNestedAttribute{
+							NestedObject: memberNestedObject,
+							Computed:     true,
+							Description:  "Groups who are members of the project. Only set when `include_details` is `true`.",
+						},
+						"repos": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Keys of the repositories assigned to the project. Only set when `include_details` is `true`.",
+						},
+						"member_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of users who are members of the project. Only set when `include_counts` or `include_details` is `true`.",
+						},
+						"group_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of groups who are members of the project. Only set when `include_counts` or `include_details` is `true`.",
+						},
+						"repository_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of repositories assigned to the project. Only set when `include_counts` or `include_details` is `true`.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Projects visible to the access token matching the filters, sorted by key.",
+			},
+		},
+		Description: "Lists the projects visible to the access token, e.g. to iterate over projects with `for_each`.",
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var data ProjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var displayNameRegex *regexp.Regexp
+	if !data.DisplayNameRegex.IsNull() {
+		re, err := regexp.Compile(data.DisplayNameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("display_name_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+			return
+		}
+		displayNameRegex = re
+	}
+
+	var projects []ProjectAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetResult(&projects).
+		SetError(&projectError).
+		Get(ProjectsUrl)
+	if err != nil {
+		UnableToReadDataSourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		UnableToReadDataSourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Key < projects[j].Key
+	})
+
+	data.Projects = []ProjectsProjectModel{}
+	for _, project := range projects {
+		if !strings.HasPrefix(project.Key, data.KeyPrefix.ValueString()) {
+			continue
+		}
+		if displayNameRegex != nil && !displayNameRegex.MatchString(project.DisplayName) {
+			continue
+		}
+
+		data.Projects = append(data.Projects, ProjectsProjectModel{
+			Key:         types.StringValue(project.Key),
+			DisplayName: types.StringValue(project.DisplayName),
+			Description: types.StringValue(project.Description),
+			Members:     types.SetNull(types.ObjectType{AttrTypes: memberAttrTypes}),
+			Groups:      types.SetNull(types.ObjectType{AttrTypes: memberAttrTypes}),
+			Repos:       types.SetNull(types.StringType),
+			MemberCount: types.Int64Null(),
+			GroupCount:  types.Int64Null(),
+			RepoCount:   types.Int64Null(),
+		})
+	}
+
+	if data.IncludeDetails.ValueBool() || data.IncludeCounts.ValueBool() {
+		projectKeys := make([]string, len(data.Projects))
+		for i, project := range data.Projects {
+			projectKeys[i] = project.Key.ValueString()
+		}
+
+		details, err := readProjectDetails(ctx, projectKeys, allProjectDetails, d.ProviderData.Client)
+		if err != nil {
+			UnableToReadDataSourceError(resp, err.Error())
+			return
+		}
+
+		for i := range data.Projects {
+			data.Projects[i].MemberCount = types.Int64Value(int64(len(details[i].Users)))
+			data.Projects[i].GroupCount = types.Int64Value(int64(len(details[i].Groups)))
+			data.Projects[i].RepoCount = types.Int64Value(int64(len(details[i].Repos)))
+
+			if !data.IncludeDetails.ValueBool() {
+				continue
+			}
+
+			members, ds := memberAPIModelsToResourceSet(ctx, details[i].Users)
+			resp.Diagnostics.Append(ds...)
+
+			groups, ds := memberAPIModelsToResourceSet(ctx, details[i].Groups)
+			resp.Diagnostics.Append(ds...)
+
+			repos, ds := types.SetValueFrom(ctx, types.StringType, details[i].Repos)
+			resp.Diagnostics.Append(ds...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			data.Projects[i].Members = members
+			data.Projects[i].Groups = groups
+			data.Projects[i].Repos = repos
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,106 @@
+package project_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectsDataSource", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		data "projects" "by_key" {
+			key_prefix = project.{{ .name }}.key
+		}
+
+		data "projects" "by_display_name" {
+			display_name_regex = "^${project.{{ .name }}.display_name}$"
+		}
+
+		data "projects" "with_details" {
+			key_prefix = project.{{ .name }}.key
+			include_details = true
+		}
+
+		data "projects" "with_counts" {
+			key_prefix = project.{{ .name }}.key
+			include_counts = true
+		}
+
+		data "projects" "none" {
+			key_prefix = project.{{ .name }}.key
+			display_name_regex = "^no-match$"
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.projects.by_key", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.projects.by_key", "projects.0.key", projectKey),
+					resource.TestCheckResourceAttr("data.projects.by_key", "projects.0.display_name", name),
+					resource.TestCheckResourceAttr("data.projects.by_key", "projects.0.description", "test description"),
+					resource.TestCheckResourceAttr("data.projects.by_display_name", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.projects.by_display_name", "projects.0.key", projectKey),
+					resource.TestCheckNoResourceAttr("data.projects.by_key", "projects.0.repos"),
+					resource.TestCheckResourceAttr("data.projects.with_details", "projects.#", "1"),
+					resource.TestCheckResourceAttrSet("data.projects.with_details", "projects.0.members.#"),
+					resource.TestCheckResourceAttr("data.projects.with_details", "projects.0.groups.#", "0"),
+					resource.TestCheckResourceAttr("data.projects.with_details", "projects.0.repos.#", "0"),
+					resource.TestCheckResourceAttr("data.projects.with_details", "projects.0.group_count", "0"),
+					resource.TestCheckResourceAttr("data.projects.with_details", "projects.0.repository_count", "0"),
+					resource.TestCheckResourceAttr("data.projects.with_counts", "projects.#", "1"),
+					resource.TestCheckResourceAttrSet("data.projects.with_counts", "projects.0.member_count"),
+					resource.TestCheckResourceAttr("data.projects.with_counts", "projects.0.group_count", "0"),
+					resource.TestCheckResourceAttr("data.projects.with_counts", "projects.0.repository_count", "0"),
+					resource.TestCheckNoResourceAttr("data.projects.with_counts", "projects.0.repos"),
+					resource.TestCheckResourceAttr("data.projects.none", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectsDataSource_invalid_regex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "projects" "invalid" {
+						display_name_regex = "["
+					}
+				`,
+				ExpectError: regexp.MustCompile(".*Invalid regular expression.*"),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+// Key of the private data holding the grant to revoke on close
+const userGrantPrivateKey = "grant"
+
+func NewProjectUserGrantEphemeralResource() ephemeral.EphemeralResource {
+	return &ProjectUserGrantEphemeralResource{
+		TypeName: "project_user_grant",
+	}
+}
+
+type ProjectUserGrantEphemeralResource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectUserGrantEphemeralResourceModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+	Name       types.String `tfsdk:"name"`
+	Roles      types.Set    `tfsdk:"roles"`
+}
+
+// userGrantPrivateData is kept in the ephemeral resource private data so the user can be
+// restored to the membership it had before the grant when the resource is closed.
+type userGrantPrivateData struct {
+	ProjectKey    string   `json:"project_key"`
+	Name          string   `json:"name"`
+	PreviousRoles []string `json:"previous_roles"`
+}
+
+func (r *ProjectUserGrantEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectUserGrantEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project in which the roles are granted.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of an artifactory user.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				Description: "List of pre-defined Project or custom roles granted to the user for the duration of the Terraform run, e.g. 'Project Admin'. Roles the user already has are kept.",
+			},
+		},
+		Description: "Grants a user roles in a project for the duration of the Terraform run, for break-glass or just-in-time workflows. The roles are granted when the ephemeral resource is opened and revoked when it is closed, restoring the membership the user had before. The grant is never stored in plan or state. Requires Terraform 1.10 or later.",
+	}
+}
+
+func (r *ProjectUserGrantEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (r *ProjectUserGrantEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var data ProjectUserGrantEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := data.ProjectKey.ValueString()
+	name := data.Name.ValueString()
+
+	var roles []string
+	resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := readMember(ctx, projectKey, usersMembershipType, name, r.ProviderData.Client)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Project Roles", err.Error())
+		return
+	}
+
+	previousRoles := []string{}
+	if member != nil {
+		previousRoles = member.Roles
+	}
+
+	private, err := json.Marshal(userGrantPrivateData{
+		ProjectKey:    projectKey,
+		Name:          name,
+		PreviousRoles: previousRoles,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Project Roles", err.Error())
+		return
+	}
+
+	err = updateMember(ctx, projectKey, usersMembershipType, MemberAPIModel{
+		Name:  name,
+		Roles: lo.Union(previousRoles, roles),
+	}, r.ProviderData.Client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Grant Project Roles",
+			fmt.Sprintf("failed to grant roles to user '%s' in project '%s': %s", name, projectKey, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, userGrantPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ProjectUserGrantEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	private, diags := req.Private.GetKey(ctx, userGrantPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var grant userGrantPrivateData
+	if err := json.Unmarshal(private, &grant); err != nil {
+		resp.Diagnostics.AddError("Unable to Revoke Project Roles", err.Error())
+		return
+	}
+
+	var err error
+	if len(grant.PreviousRoles) == 0 {
+		err = deleteMember(ctx, grant.ProjectKey, usersMembershipType, MemberAPIModel{Name: grant.Name}, r.ProviderData.Client)
+	} else {
+		err = updateMember(ctx, grant.ProjectKey, usersMembershipType, MemberAPIModel{
+			Name:  grant.Name,
+			Roles: grant.PreviousRoles,
+		}, r.ProviderData.Client)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Revoke Project Roles",
+			fmt.Sprintf("failed to restore the membership of user '%s' in project '%s': %s", grant.Name, grant.ProjectKey, err),
+		)
+	}
+}
@@ -0,0 +1,103 @@
+package project_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectUserGrantEphemeralResource(t *testing.T) {
+	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	username := fmt.Sprintf("tftestuser%s", strings.ToLower(acctest.RandSeq(5)))
+	email := username + "@tempurl.org"
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"username":     username,
+		"email":        email,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectUserGrant", `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .email }}"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		ephemeral "project_user_grant" "{{ .username }}" {
+			project_key = project.{{ .project_name }}.key
+			name        = artifactory_managed_user.{{ .username }}.name
+			roles       = ["Developer"]
+		}
+
+		provider "echo" {
+			data = ephemeral.project_user_grant.{{ .username }}
+		}
+
+		resource "echo" "test" {}
+	`, params)
+
+	providerFactories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range acctest.ProtoV6ProviderFactories {
+		providerFactories[name] = factory
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: providerFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.name", username),
+					resource.TestCheckResourceAttr("echo.test", "data.roles.#", "1"),
+					resource.TestCheckResourceAttr("echo.test", "data.roles.0", "Developer"),
+					// the grant is revoked when the ephemeral resource is closed
+					func(_ *terraform.State) error {
+						resp, err := verifyProjectUser(username, projectKey, acctest.GetTestResty(t).R())
+						if err != nil {
+							return err
+						}
+						if resp.StatusCode() != http.StatusNotFound {
+							return fmt.Errorf("expected user %s to be removed from project %s, got status %d", username, projectKey, resp.StatusCode())
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var projectIdAttrTypes = map[string]attr.Type{
+	"project_key": types.StringType,
+	"name":        types.StringType,
+}
+
+func NewParseProjectIdFunction() function.Function {
+	return &ParseProjectIdFunction{}
+}
+
+type ParseProjectIdFunction struct{}
+
+func (f *ParseProjectIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_project_id"
+}
+
+func (f *ParseProjectIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a project scoped ID",
+		Description: "Splits a `project_key:name` ID, as used to import `project_user`, `project_group`, `project_role`, `project_environment` and `project_repository`, into an object with `project_key` and `name` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "ID in the `project_key:name` format.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: projectIdAttrTypes,
+		},
+	}
+}
+
+func (f *ParseProjectIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Expected project_key:name, got '%s'", id))
+		return
+	}
+
+	result, diags := types.ObjectValue(projectIdAttrTypes, map[string]attr.Value{
+		"project_key": types.StringValue(parts[0]),
+		"name":        types.StringValue(parts[1]),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,45 @@
+package project_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+)
+
+func TestAccParseProjectIdFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "id" {
+						value = provider::project::parse_project_id("myproj:my-group:with-colon")
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("id", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"project_key": knownvalue.StringExact("myproj"),
+						"name":        knownvalue.StringExact("my-group:with-colon"),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "id" {
+						value = provider::project::parse_project_id("myproj")
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Expected project_key:name, got 'myproj'`),
+			},
+		},
+	})
+}
@@ -0,0 +1,45 @@
+package project
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Same rule as the `key` attribute of the project resource
+var projectKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9\-]{1,31}$`)
+
+func NewValidKeyFunction() function.Function {
+	return &ValidKeyFunction{}
+}
+
+type ValidKeyFunction struct{}
+
+func (f *ValidKeyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "valid_key"
+}
+
+func (f *ValidKeyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check a project key",
+		Description: "Returns whether the string is a valid project key: 2 - 32 lowercase alphanumeric and hyphen characters, starting with a letter. Use it in variable validations or preconditions to reject keys derived from variables before anything is created. Keys longer than 10 characters require Artifactory 7.56.2 or later, which is only checked by the `project` resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "Candidate project key.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ValidKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, projectKeyRegex.MatchString(key)))
+}
@@ -0,0 +1,53 @@
+package project_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+)
+
+func TestAccValidKeyFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "valid" {
+						value = provider::project::valid_key("my-proj1")
+					}
+
+					output "starts_with_digit" {
+						value = provider::project::valid_key("1proj")
+					}
+
+					output "uppercase" {
+						value = provider::project::valid_key("MyProj")
+					}
+
+					output "too_short" {
+						value = provider::project::valid_key("p")
+					}
+
+					output "too_long" {
+						value = provider::project::valid_key("p12345678901234567890123456789012")
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("valid", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("starts_with_digit", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("uppercase", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("too_short", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("too_long", knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
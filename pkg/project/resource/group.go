@@ -0,0 +1,81 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const platformGroupsUrl = "/access/api/v2/groups"
+const platformGroupUrl = platformGroupsUrl + "/{name}"
+
+type PlatformGroupAPIModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// createGroupIfMissing creates the platform group unless it exists already, and reports
+// whether it was created.
+var createGroupIfMissing = func(ctx context.Context, name string, client *resty.Client) (bool, error) {
+	tflog.Debug(ctx, "createGroupIfMissing")
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetContext(ctx).
+		SetPathParam("name", name).
+		SetError(&projectError).
+		Get(platformGroupUrl)
+	if err != nil {
+		return false, err
+	}
+	if resp.IsSuccess() {
+		return false, nil
+	}
+	if resp.StatusCode() != http.StatusNotFound {
+		return false, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	resp, err = client.R().
+		SetContext(ctx).
+		SetBody(PlatformGroupAPIModel{
+			Name:        name,
+			Description: "Created by Terraform for project membership",
+		}).
+		SetError(&projectError).
+		Post(platformGroupsUrl)
+	if err != nil {
+		return false, err
+	}
+	// Created concurrently, e.g. by another project_group resource of the same group
+	if resp.StatusCode() == http.StatusConflict {
+		return false, nil
+	}
+	if resp.IsError() {
+		return false, fmt.Errorf("failed to create group %s: %s", name, apiErrorMessage(resp, projectError))
+	}
+
+	return true, nil
+}
+
+// deleteGroup deletes the platform group. A group that is already gone is not an error.
+var deleteGroup = func(ctx context.Context, name string, client *resty.Client) error {
+	tflog.Debug(ctx, "deleteGroup")
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetContext(ctx).
+		SetPathParam("name", name).
+		SetError(&projectError).
+		Delete(platformGroupUrl)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("failed to delete group %s: %s", name, apiErrorMessage(resp, projectError))
+	}
+
+	return nil
+}
@@ -4,9 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
 )
 
 const projectMembershipsUrl = ProjectUrl + "/{membershipType}"
@@ -15,6 +23,43 @@ const projectMembershipUrl = projectMembershipsUrl + "/{memberName}"
 const usersMembershipType = "users"
 const groupsMembershipType = "groups"
 
+// Number of membership requests sent at once when updating the members of a project
+const membershipRequestsConcurrency = 8
+
+const projectAdminRole = "Project Admin"
+
+// How members added outside Terraform, e.g. platform admins added automatically, are handled
+const (
+	unmanagedMembersIgnore = "ignore"
+	unmanagedMembersWarn   = "warn"
+	unmanagedMembersRemove = "remove"
+)
+
+var unmanagedMembersActions = []string{unmanagedMembersIgnore, unmanagedMembersWarn, unmanagedMembersRemove}
+
+// Number of members requested per page when listing project users or groups
+const membershipPageSize = 500
+
+// Number of times a membership upsert is retried when the user or group isn't found yet
+const memberNotFoundMaxRetries = 5
+
+var memberNotFoundRegex = regexp.MustCompile(`(?i)(user|group).*(not found|does not exist)`)
+
+// retryOnMemberNotFound retries membership upserts rejected because the user or group
+// doesn't exist yet. This happens briefly when the user or group is created by another
+// resource (e.g. from the artifactory provider) in the same apply.
+func retryOnMemberNotFound(resp *resty.Response, err error) bool {
+	if err != nil || resp == nil {
+		return false
+	}
+
+	if resp.StatusCode() != http.StatusBadRequest && resp.StatusCode() != http.StatusNotFound {
+		return false
+	}
+
+	return resp.Request.Attempt <= memberNotFoundMaxRetries && memberNotFoundRegex.MatchString(resp.String())
+}
+
 // Use by both project user and project group, as they shared identical data structure
 type MemberAPIModel struct {
 	Name  string   `json:"name"`
@@ -41,13 +86,54 @@ var readMembers = func(ctx context.Context, projectKey, membershipType string, c
 		return nil, fmt.Errorf("invalid membershipType: %s", membershipType)
 	}
 
+	var members []MemberAPIModel
+	seen := map[string]bool{}
+
+	for offset := 0; ; offset += membershipPageSize {
+		page, err := readMembersPage(ctx, projectKey, membershipType, offset, client)
+		if err != nil {
+			return nil, err
+		}
+
+		newMembers := lo.Filter(page, func(member MemberAPIModel, _ int) bool {
+			return !seen[member.Name]
+		})
+		for _, member := range newMembers {
+			seen[member.Name] = true
+		}
+		members = append(members, newMembers...)
+
+		// Older Access versions ignore the paging parameters and return the whole list
+		// in one go. Stop on a short page, an oversized page, or a page with nothing new
+		// so we never loop forever against such servers.
+		if len(page) != membershipPageSize || len(newMembers) == 0 {
+			break
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("readMembers: %+v\n", members))
+
+	return members, nil
+}
+
+func readMembersPage(ctx context.Context, projectKey, membershipType string, offset int, client *resty.Client) ([]MemberAPIModel, error) {
+	tflog.Debug(ctx, "readMembersPage", map[string]interface{}{
+		"offset": offset,
+		"limit":  membershipPageSize,
+	})
+
 	var membership MembershipAPIModel
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey":     projectKey,
 			"membershipType": membershipType,
 		}).
+		SetQueryParams(map[string]string{
+			"offset": strconv.Itoa(offset),
+			"limit":  strconv.Itoa(membershipPageSize),
+		}).
 		SetResult(&membership).
 		SetError(&projectError).
 		Get(projectMembershipsUrl)
@@ -55,15 +141,53 @@ var readMembers = func(ctx context.Context, projectKey, membershipType string, c
 		return nil, err
 	}
 	if resp.IsError() {
-		return nil, fmt.Errorf("%s", projectError.String())
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("readMembers: %+v\n", membership))
-
 	return membership.Members, nil
 }
 
-var updateMembers = func(ctx context.Context, projectKey, membershipType string, members []MemberAPIModel, client *resty.Client) ([]MemberAPIModel, error) {
+// partitionManagedMembers splits members into those found in managedMembers and the others
+func partitionManagedMembers(members, managedMembers []MemberAPIModel) ([]MemberAPIModel, []MemberAPIModel) {
+	managedSet := SetFromSlice(managedMembers)
+	return lo.FilterReject(members, func(member MemberAPIModel, _ int) bool {
+		return managedSet.Contains(member)
+	})
+}
+
+// readMember returns the membership of a single user or group, or nil when it isn't a member
+var readMember = func(ctx context.Context, projectKey, membershipType, memberName string, client *resty.Client) (*MemberAPIModel, error) {
+	tflog.Debug(ctx, "readMember")
+
+	var member MemberAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetContext(ctx).
+		SetPathParams(map[string]string{
+			"projectKey":     projectKey,
+			"membershipType": membershipType,
+			"memberName":     memberName,
+		}).
+		SetResult(&member).
+		SetError(&projectError).
+		Get(projectMembershipUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	return &member, nil
+}
+
+// updateMembers sets the members of the project. Members not in members are removed when
+// unmanagedAction is unmanagedMembersRemove. Otherwise only those in previousMembers, i.e.
+// removed from the configuration, are removed and members added outside Terraform are kept.
+var updateMembers = func(ctx context.Context, projectKey, membershipType string, members, previousMembers []MemberAPIModel, unmanagedAction string, client *resty.Client) ([]MemberAPIModel, error) {
 	tflog.Debug(ctx, "updateMembers")
 	tflog.Trace(ctx, fmt.Sprintf("terraformMembership.Members: %+v\n", members))
 
@@ -82,16 +206,32 @@ var updateMembers = func(ctx context.Context, projectKey, membershipType string,
 
 	membersToBeAdded := terraformMembersSet.Difference(projectMembersSet)
 	tflog.Trace(ctx, fmt.Sprintf("membersToBeAdded: %+v\n", membersToBeAdded))
-	membersToBeUpdated := terraformMembersSet.Intersection(projectMembersSet)
+	// Members are equal by name, only those whose roles changed need a request
+	projectMembersByName := lo.KeyBy(projectMembers, func(member MemberAPIModel) string { return member.Name })
+	membersToBeUpdated := lo.Filter(terraformMembersSet.Intersection(projectMembersSet), func(member MemberAPIModel, _ int) bool {
+		added, removed := lo.Difference(member.Roles, projectMembersByName[member.Name].Roles)
+		return len(added) > 0 || len(removed) > 0
+	})
 	tflog.Trace(ctx, fmt.Sprintf("membersToBeUpdated: %+v\n", membersToBeUpdated))
 	membersToBeDeleted := projectMembersSet.Difference(terraformMembersSet)
+	if unmanagedAction != unmanagedMembersRemove {
+		membersToBeDeleted = membersToBeDeleted.Intersection(SetFromSlice(previousMembers))
+	}
 	tflog.Trace(ctx, fmt.Sprintf("membersToBeDeleted: %+v\n", membersToBeDeleted))
 
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(membershipRequestsConcurrency)
 	for _, member := range append(membersToBeAdded, membersToBeUpdated...) {
-		err := updateMember(ctx, projectKey, membershipType, member, client)
-		if err != nil {
-			return nil, fmt.Errorf("failed to update members %s: %s", member, err)
-		}
+		g.Go(func() error {
+			err := updateMember(gctx, projectKey, membershipType, member, client)
+			if err != nil {
+				return fmt.Errorf("failed to update members %s: %s", member, err)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
 	deleteErr := deleteMembers(ctx, projectKey, membershipType, membersToBeDeleted, client)
@@ -112,6 +252,7 @@ var updateMember = func(ctx context.Context, projectKey, membershipType string,
 
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey":     projectKey,
 			"membershipType": membershipType,
@@ -119,12 +260,13 @@ var updateMember = func(ctx context.Context, projectKey, membershipType string,
 		}).
 		SetBody(member).
 		SetError(&projectError).
+		AddRetryCondition(retryOnMemberNotFound).
 		Put(projectMembershipUrl)
 	if err != nil {
 		return err
 	}
 	if resp.IsError() {
-		return fmt.Errorf("%s", projectError.String())
+		return fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	return err
@@ -133,14 +275,19 @@ var updateMember = func(ctx context.Context, projectKey, membershipType string,
 var deleteMembers = func(ctx context.Context, projectKey, membershipType string, members []MemberAPIModel, client *resty.Client) error {
 	tflog.Debug(ctx, "deleteMembers")
 
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(membershipRequestsConcurrency)
 	for _, member := range members {
-		err := deleteMember(ctx, projectKey, membershipType, member, client)
-		if err != nil {
-			return fmt.Errorf("failed to delete %s %s: %s", membershipType, member, err)
-		}
+		g.Go(func() error {
+			err := deleteMember(gctx, projectKey, membershipType, member, client)
+			if err != nil {
+				return fmt.Errorf("failed to delete %s %s: %s", membershipType, member, err)
+			}
+			return nil
+		})
 	}
 
-	return nil
+	return g.Wait()
 }
 
 var deleteMember = func(ctx context.Context, projectKey, membershipType string, member MemberAPIModel, client *resty.Client) error {
@@ -153,6 +300,7 @@ var deleteMember = func(ctx context.Context, projectKey, membershipType string,
 
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey":     projectKey,
 			"membershipType": membershipType,
@@ -164,8 +312,87 @@ var deleteMember = func(ctx context.Context, projectKey, membershipType string,
 		return err
 	}
 	if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
-		return fmt.Errorf("%s", projectError.String())
+		return fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	return nil
 }
+
+// isLastProjectAdmin checks if no other user or group of the project holds the Project Admin role
+var isLastProjectAdmin = func(ctx context.Context, projectKey, membershipType, memberName string, client *resty.Client) (bool, error) {
+	tflog.Debug(ctx, "isLastProjectAdmin")
+
+	for _, mt := range []string{usersMembershipType, groupsMembershipType} {
+		members, err := readMembers(ctx, projectKey, mt, client)
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch %s for project: %s", mt, err)
+		}
+
+		for _, member := range members {
+			if mt == membershipType && member.Name == memberName {
+				continue
+			}
+
+			if slices.Contains(member.Roles, projectAdminRole) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// checkLastAdminRemoval prevents a member from losing the Project Admin role it holds in
+// previousRoles, either by being removed (nil roles) or by having its roles updated, when it
+// is the last member of the project holding it
+func checkLastAdminRemoval(ctx context.Context, projectKey, membershipType, memberName string, previousRoles, roles []string, client *resty.Client) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	if !slices.Contains(previousRoles, projectAdminRole) || slices.Contains(roles, projectAdminRole) {
+		return ds
+	}
+
+	lastAdmin, err := isLastProjectAdmin(ctx, projectKey, membershipType, memberName, client)
+	if err != nil {
+		ds.AddError("Unable to check Project Admin members", err.Error())
+		return ds
+	}
+
+	if lastAdmin {
+		memberKind := "User"
+		if membershipType == groupsMembershipType {
+			memberKind = "Group"
+		}
+
+		action := "Removing it"
+		if roles != nil {
+			action = fmt.Sprintf("Removing the '%s' role from it", projectAdminRole)
+		}
+
+		ds.AddError(
+			"Unable to remove last Project Admin",
+			fmt.Sprintf("%s '%s' is the last member holding the '%s' role in project '%s'. %s would leave the project without an admin. Set `allow_last_admin_removal` to `true` to do it anyway.", memberKind, memberName, projectAdminRole, projectKey, action),
+		)
+	}
+
+	return ds
+}
+
+var invalidResourceNameCharsRegex = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// memberImportBlocks returns the `import` blocks adopting the memberships in the standalone
+// resourceType resources, e.g. `project_user`, so they aren't re-created after the `member`
+// or `group` blocks are handed over. Resource names are derived from the member names.
+func memberImportBlocks(resourceType, projectKey string, memberNames []string) string {
+	var blocks []string
+	for _, name := range memberNames {
+		resourceName := invalidResourceNameCharsRegex.ReplaceAllString(name, "_")
+		if !unicode.IsLetter(rune(resourceName[0])) && resourceName[0] != '_' {
+			resourceName = "_" + resourceName
+		}
+
+		blocks = append(blocks, fmt.Sprintf("import {\n  to = %s.%s\n  id = \"%s:%s\"\n}", resourceType, resourceName, projectKey, name))
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +21,7 @@ var readRepos = func(ctx context.Context, projectKey string, client *resty.Clien
 	var artifactoryRepos []ArtifactoryRepo
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParam("projectKey", projectKey).
 		SetResult(&artifactoryRepos).
 		SetError(&projectError).
@@ -29,7 +31,7 @@ var readRepos = func(ctx context.Context, projectKey string, client *resty.Clien
 		return nil, err
 	}
 	if resp.IsError() {
-		return nil, fmt.Errorf("%s", projectError.String())
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("artifactoryRepos: %+v\n", artifactoryRepos))
@@ -42,7 +44,9 @@ var readRepos = func(ctx context.Context, projectKey string, client *resty.Clien
 	return repoKeys, nil
 }
 
-var updateRepos = func(ctx context.Context, projectKey string, terraformRepoKeys []string, client *resty.Client) ([]string, error) {
+// updateRepos sets the repositories of the project. With force, repositories assigned to
+// another project are moved, otherwise the API rejects them.
+var updateRepos = func(ctx context.Context, projectKey string, terraformRepoKeys []string, force bool, client *resty.Client) ([]string, error) {
 	tflog.Debug(ctx, "updateRepos")
 	tflog.Trace(ctx, fmt.Sprintf("terraformRepoKeys: %+v\n", terraformRepoKeys))
 
@@ -56,7 +60,7 @@ var updateRepos = func(ctx context.Context, projectKey string, terraformRepoKeys
 	tflog.Trace(ctx, fmt.Sprintf("repoKeysToBeAdded: %+v\n", repoKeysToBeAdded))
 	tflog.Trace(ctx, fmt.Sprintf("repoKeysToBeDeleted: %+v\n", repoKeysToBeDeleted))
 
-	addErr := addRepos(ctx, projectKey, repoKeysToBeAdded, client)
+	addErr := addRepos(ctx, projectKey, repoKeysToBeAdded, force, client)
 	if addErr != nil {
 		return nil, fmt.Errorf("failed to add repos for project: %s", addErr)
 	}
@@ -69,16 +73,17 @@ var updateRepos = func(ctx context.Context, projectKey string, terraformRepoKeys
 	return readRepos(ctx, projectKey, client)
 }
 
-var addRepos = func(ctx context.Context, projectKey string, repoKeys []string, client *resty.Client) error {
+var addRepos = func(ctx context.Context, projectKey string, repoKeys []string, force bool, client *resty.Client) error {
 	tflog.Debug(ctx, fmt.Sprintf("addRepos: %s", repoKeys))
 
 	req := client.R().
+		SetContext(ctx).
 		AddRetryCondition(RetryOnSpecificMsgBody("A timeout occurred")).
 		AddRetryCondition(RetryOnSpecificMsgBody("Web server is down")).
 		AddRetryCondition(RetryOnSpecificMsgBody("Web server is returning an unknown error"))
 
 	for _, repoKey := range repoKeys {
-		err := addRepo(ctx, projectKey, repoKey, req)
+		err := addRepo(ctx, projectKey, repoKey, force, req)
 		if err != nil {
 			return fmt.Errorf("failed to add repo %s: %s", repoKey, err)
 		}
@@ -87,7 +92,7 @@ var addRepos = func(ctx context.Context, projectKey string, repoKeys []string, c
 	return nil
 }
 
-var addRepo = func(ctx context.Context, projectKey, repoKey string, req *resty.Request) error {
+var addRepo = func(ctx context.Context, projectKey, repoKey string, force bool, req *resty.Request) error {
 	tflog.Debug(ctx, fmt.Sprintf("addRepo: %s", repoKey))
 
 	var projectError ProjectErrorsResponse
@@ -96,14 +101,14 @@ var addRepo = func(ctx context.Context, projectKey, repoKey string, req *resty.R
 			"projectKey": projectKey,
 			"repoKey":    string(repoKey),
 		}).
-		SetQueryParam("force", "true").
+		SetQueryParam("force", strconv.FormatBool(force)).
 		SetError(&projectError).
 		Put(ProjectsUrl + "/_/attach/repositories/{repoKey}/{projectKey}")
 	if err != nil {
 		return err
 	}
 	if resp.IsError() {
-		return fmt.Errorf("%s", projectError.String())
+		return fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	return err
@@ -113,6 +118,7 @@ var deleteRepos = func(ctx context.Context, repoKeys []string, client *resty.Cli
 	tflog.Debug(ctx, fmt.Sprintf("deleteRepos: %s", repoKeys))
 
 	req := client.R().
+		SetContext(ctx).
 		AddRetryCondition(RetryOnSpecificMsgBody("A timeout occurred")).
 		AddRetryCondition(RetryOnSpecificMsgBody("Web server is down")).
 		AddRetryCondition(RetryOnSpecificMsgBody("Web server is returning an unknown error"))
@@ -150,8 +156,34 @@ var deleteRepo = func(ctx context.Context, repoKey string, req *resty.Request) e
 			}
 		}
 	} else if resp.IsError() {
-		return fmt.Errorf("%s", projectError.String())
+		return fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	return nil
 }
+
+// readRepoProjectKey returns the key of the project the repository is assigned to, or an
+// empty string when it isn't assigned or doesn't exist.
+var readRepoProjectKey = func(ctx context.Context, repoKey string, client *resty.Client) (string, error) {
+	tflog.Debug(ctx, "readRepoProjectKey")
+
+	var repo ProjectRepositoryAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetContext(ctx).
+		SetPathParam("key", repoKey).
+		SetResult(&repo).
+		SetError(&projectError).
+		Get(repositoryEndpoint)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return "", nil
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	return repo.ProjectKey, nil
+}
@@ -2,12 +2,15 @@ package project_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
 	"github.com/jfrog/terraform-provider-shared/util"
 )
 
@@ -383,3 +386,111 @@ func TestAccProject_repoUnassignNonexistantRepo(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProject_repoForceMove(t *testing.T) {
+	name := "tftestprojects" + acctest.RandSeq(10)
+	resourceName := "project." + name
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	otherProjectKey := strings.ToLower(acctest.RandSeq(10))
+
+	repo := fmt.Sprintf("repo%s", strings.ToLower(acctest.RandSeq(6)))
+
+	template := `
+		resource "artifactory_local_generic_repository" "{{ .repo }}" {
+			key = "{{ .repo }}"
+
+			lifecycle {
+				ignore_changes = [project_key]
+			}
+		}
+
+		resource "project" "other" {
+			key = "{{ .other_project_key }}"
+			display_name = "other{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			force_delete_with_repos = true
+		}
+
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_repository_resource = false
+			force_move_repos = {{ .force_move_repos }}
+			{{ if .assign }}repos = ["{{ .repo }}"]{{ end }}
+
+			depends_on = [
+				artifactory_local_generic_repository.{{ .repo }},
+				project.other,
+			]
+		}
+	`
+	makeConfig := func(assign, forceMoveRepos bool) string {
+		return util.ExecuteTemplate("TestAccProjectRepoForceMove", template, map[string]interface{}{
+			"name":              name,
+			"project_key":       projectKey,
+			"other_project_key": otherProjectKey,
+			"repo":              repo,
+			"assign":            assign,
+			"force_move_repos":  forceMoveRepos,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: makeConfig(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_move_repos", "true"),
+					resource.TestCheckResourceAttr(resourceName, "repos.#", "0"),
+					// Repository assigned to the other project outside of Terraform
+					func(_ *terraform.State) error {
+						resp, err := acctest.GetTestResty(t).R().
+							SetPathParams(map[string]string{
+								"projectKey": otherProjectKey,
+								"repoKey":    repo,
+							}).
+							SetQueryParam("force", "true").
+							Put(project.ProjectsUrl + "/_/attach/repositories/{repoKey}/{projectKey}")
+						if err != nil {
+							return err
+						}
+						if resp.IsError() {
+							return fmt.Errorf("failed to assign repo %s: %s", repo, resp.String())
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      makeConfig(true, false),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`.*%s \(from project '%s'\).*`, repo, otherProjectKey)),
+			},
+			{
+				Config: makeConfig(true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_move_repos", "true"),
+					resource.TestCheckResourceAttr(resourceName, "repos.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "repos.0", repo),
+				),
+			},
+		},
+	})
+}
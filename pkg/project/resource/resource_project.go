@@ -2,14 +2,19 @@ package project
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -18,11 +23,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -44,7 +53,7 @@ func NewProjectResource() resource.Resource {
 }
 
 type ProjectResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
@@ -114,6 +123,64 @@ type ProjectResourceModelV4 struct {
 	UseProjectUserResource       types.Bool   `tfsdk:"use_project_user_resource"`
 	UseProjectGroupResource      types.Bool   `tfsdk:"use_project_group_resource"`
 	UseProjectRepositoryResource types.Bool   `tfsdk:"use_project_repository_resource"`
+	MaxStorage                   types.Int64  `tfsdk:"max_storage"`
+	StorageQuotaUnit             types.String `tfsdk:"storage_quota_unit"`
+	WaitForReady                 types.Bool   `tfsdk:"wait_for_ready"`
+	PollInterval                 types.Int64  `tfsdk:"poll_interval"`
+	MaxWait                      types.Int64  `tfsdk:"max_wait"`
+}
+
+type ProjectResourceModelV5 struct {
+	ID                           types.String `tfsdk:"id"`
+	Key                          types.String `tfsdk:"key"`
+	DisplayName                  types.String `tfsdk:"display_name"`
+	Description                  types.String `tfsdk:"description"`
+	AdminPrivileges              types.Object `tfsdk:"admin_privileges"`
+	MaxStorageInGibibytes        types.Int64  `tfsdk:"max_storage_in_gibibytes"`
+	SoftLimit                    types.Bool   `tfsdk:"block_deployments_on_limit"`
+	QuotaEmailNotification       types.Bool   `tfsdk:"email_notification"`
+	Members                      types.Set    `tfsdk:"member"`
+	Groups                       types.Set    `tfsdk:"group"`
+	Roles                        types.Set    `tfsdk:"role"`
+	Repos                        types.Set    `tfsdk:"repos"`
+	UseProjectRoleResource       types.Bool   `tfsdk:"use_project_role_resource"`
+	UseProjectUserResource       types.Bool   `tfsdk:"use_project_user_resource"`
+	UseProjectGroupResource      types.Bool   `tfsdk:"use_project_group_resource"`
+	UseProjectRepositoryResource types.Bool   `tfsdk:"use_project_repository_resource"`
+	MaxStorage                   types.Int64  `tfsdk:"max_storage"`
+	StorageQuotaUnit             types.String `tfsdk:"storage_quota_unit"`
+	WaitForReady                 types.Bool   `tfsdk:"wait_for_ready"`
+	PollInterval                 types.Int64  `tfsdk:"poll_interval"`
+	MaxWait                      types.Int64  `tfsdk:"max_wait"`
+	QuotaWarningThreshold        types.Int64  `tfsdk:"quota_warning_threshold"`
+	QuotaExceeded                types.Bool   `tfsdk:"quota_exceeded"`
+	OverSoftLimit                types.Bool   `tfsdk:"over_soft_limit"`
+	PreventRename                types.Bool   `tfsdk:"prevent_rename"`
+	UnmanagedMembersAction       types.String `tfsdk:"unmanaged_members_action"`
+	AdoptExisting                types.Bool   `tfsdk:"adopt_existing"`
+	DeletionProtection           types.Bool   `tfsdk:"deletion_protection"`
+	ForceDeleteWithRepos         types.Bool   `tfsdk:"force_delete_with_repos"`
+	ValidateQuotaAgainstUsage    types.Bool   `tfsdk:"validate_quota_against_usage"`
+	EnforceQuotaBlockDeployments types.Bool   `tfsdk:"enforce_quota_block_deployments"`
+	ForceMoveRepos               types.Bool   `tfsdk:"force_move_repos"`
+	Timeouts                     types.Object `tfsdk:"timeouts"`
+}
+
+// refreshQuotaStatus compares the storage usage of the project to its quota. Reading the
+// usage lists the storage summary of every repository, so it is skipped unless
+// `quota_warning_threshold` is set.
+func (r *ProjectResourceModelV5) refreshQuotaStatus(ctx context.Context, storageQuota int64, client *resty.Client) diag.Diagnostics {
+	if r.QuotaWarningThreshold.IsNull() {
+		r.QuotaExceeded = types.BoolNull()
+		r.OverSoftLimit = types.BoolNull()
+		return nil
+	}
+
+	status, diags := checkQuotaUtilization(ctx, r.Key.ValueString(), storageQuota, r.QuotaWarningThreshold.ValueInt64(), client)
+	r.QuotaExceeded = status.Exceeded
+	r.OverSoftLimit = status.OverSoftLimit
+
+	return diags
 }
 
 var adminPrivilegesAttrType = map[string]attr.Type{
@@ -122,10 +189,6 @@ var adminPrivilegesAttrType = map[string]attr.Type{
 	"index_resources":  types.BoolType,
 }
 
-var adminPrivilegesElemType = types.ObjectType{
-	AttrTypes: adminPrivilegesAttrType,
-}
-
 var memberAttrTypes = map[string]attr.Type{
 	"name":  types.StringType,
 	"roles": types.SetType{ElemType: types.StringType},
@@ -174,7 +237,7 @@ func memberAPIModelsToResourceSet(ctx context.Context, members []MemberAPIModel)
 	return types.SetValue(memberElemType, membersSet)
 }
 
-func (r *ProjectResourceModelV4) fromAPIModel(ctx context.Context, apiModel ProjectAPIModel, users, groups []MemberAPIModel, roles []Role, repos []string) diag.Diagnostics {
+func (r *ProjectResourceModelV5) fromAPIModel(ctx context.Context, apiModel ProjectAPIModel, users, groups []MemberAPIModel, roles []Role, repos []string) diag.Diagnostics {
 	ds := diag.Diagnostics{}
 
 	r.ID = types.StringValue(apiModel.Key) // backward compatibility
@@ -186,7 +249,44 @@ func (r *ProjectResourceModelV4) fromAPIModel(ctx context.Context, apiModel Proj
 	}
 
 	r.MaxStorageInGibibytes = types.Int64Value(BytesToGibibytes(apiModel.StorageQuota))
+	if r.StorageQuotaUnit.IsNull() {
+		r.StorageQuotaUnit = types.StringValue(defaultStorageQuotaUnit)
+	}
+	if !r.MaxStorage.IsNull() {
+		r.MaxStorage = types.Int64Value(BytesToStorageQuota(apiModel.StorageQuota, r.StorageQuotaUnit.ValueString()))
+	}
+	if r.WaitForReady.IsNull() {
+		r.WaitForReady = types.BoolValue(false)
+	}
+	if r.PollInterval.IsNull() {
+		r.PollInterval = types.Int64Value(defaultPollInterval)
+	}
+	if r.MaxWait.IsNull() {
+		r.MaxWait = types.Int64Value(defaultMaxWait)
+	}
+	if r.PreventRename.IsNull() {
+		r.PreventRename = types.BoolValue(false)
+	}
+	if r.UnmanagedMembersAction.IsNull() {
+		r.UnmanagedMembersAction = types.StringValue(unmanagedMembersRemove)
+	}
+	if r.AdoptExisting.IsNull() {
+		r.AdoptExisting = types.BoolValue(false)
+	}
+	if r.DeletionProtection.IsNull() {
+		r.DeletionProtection = types.BoolValue(false)
+	}
+	if r.ForceDeleteWithRepos.IsNull() {
+		r.ForceDeleteWithRepos = types.BoolValue(false)
+	}
+	if r.ValidateQuotaAgainstUsage.IsNull() {
+		r.ValidateQuotaAgainstUsage = types.BoolValue(false)
+	}
+	if r.ForceMoveRepos.IsNull() {
+		r.ForceMoveRepos = types.BoolValue(true)
+	}
 	r.SoftLimit = types.BoolValue(!apiModel.SoftLimit)
+	r.EnforceQuotaBlockDeployments = types.BoolValue(!apiModel.SoftLimit)
 	r.QuotaEmailNotification = types.BoolValue(apiModel.QuotaEmailNotification)
 
 	ap := map[string]attr.Value{
@@ -194,11 +294,7 @@ func (r *ProjectResourceModelV4) fromAPIModel(ctx context.Context, apiModel Proj
 		"manage_resources": types.BoolValue(apiModel.AdminPrivileges.ManageResources),
 		"index_resources":  types.BoolValue(apiModel.AdminPrivileges.IndexResources),
 	}
-	apObj, d := types.ObjectValue(adminPrivilegesAttrType, ap)
-	if d.HasError() {
-		ds.Append(d...)
-	}
-	adminPrivileges, d := types.SetValue(adminPrivilegesElemType, []attr.Value{apObj})
+	adminPrivileges, d := types.ObjectValue(adminPrivilegesAttrType, ap)
 	if d.HasError() {
 		ds.Append(d...)
 	}
@@ -287,6 +383,52 @@ func BytesToGibibytes(bytes int64) int64 {
 	return int64(bytes / int64(math.Pow(1024, 3)))
 }
 
+// Defaults in seconds for waiting on a new project to become ready
+const (
+	defaultPollInterval = 2
+	defaultMaxWait      = 60
+)
+
+const defaultStorageQuotaUnit = "GB"
+
+// storageQuotaUnits maps the supported `storage_quota_unit` values to their size in bytes.
+// Units are binary to stay consistent with `max_storage_in_gibibytes`.
+var storageQuotaUnits = map[string]int64{
+	"MB": int64(math.Pow(1024, 2)),
+	"GB": int64(math.Pow(1024, 3)),
+	"TB": int64(math.Pow(1024, 4)),
+}
+
+func StorageQuotaToBytes(quota int64, unit string) (int64, error) {
+	if quota <= -1 {
+		return -1, nil
+	}
+
+	multiplier, ok := storageQuotaUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("unsupported storage quota unit: %s", unit)
+	}
+
+	if quota > math.MaxInt64/multiplier {
+		return 0, fmt.Errorf("storage quota %d %s is too large", quota, unit)
+	}
+
+	return quota * multiplier, nil
+}
+
+func BytesToStorageQuota(bytes int64, unit string) int64 {
+	if bytes <= -1 {
+		return -1
+	}
+
+	multiplier, ok := storageQuotaUnits[unit]
+	if !ok {
+		multiplier = storageQuotaUnits[defaultStorageQuotaUnit]
+	}
+
+	return bytes / multiplier
+}
+
 func resourceMemberToAPIModels(ctx context.Context, members types.Set) ([]MemberAPIModel, diag.Diagnostics) {
 	ds := diag.Diagnostics{}
 
@@ -311,7 +453,7 @@ func resourceMemberToAPIModels(ctx context.Context, members types.Set) ([]Member
 	return ms, ds
 }
 
-func (r ProjectResourceModelV4) toAPIModel(ctx context.Context, project *ProjectAPIModel, users, groups *[]MemberAPIModel, roles *[]Role, repos *[]string) diag.Diagnostics {
+func (r ProjectResourceModelV5) toAPIModel(ctx context.Context, project *ProjectAPIModel, users, groups *[]MemberAPIModel, roles *[]Role, repos *[]string) diag.Diagnostics {
 	ds := diag.Diagnostics{}
 
 	proj := ProjectAPIModel{
@@ -323,8 +465,17 @@ func (r ProjectResourceModelV4) toAPIModel(ctx context.Context, project *Project
 		QuotaEmailNotification: r.QuotaEmailNotification.ValueBool(),
 	}
 
+	if !r.MaxStorage.IsNull() {
+		storageQuota, err := StorageQuotaToBytes(r.MaxStorage.ValueInt64(), r.StorageQuotaUnit.ValueString())
+		if err != nil {
+			ds.AddAttributeError(path.Root("max_storage"), "Invalid storage quota", err.Error())
+			return ds
+		}
+		proj.StorageQuota = storageQuota
+	}
+
 	if !r.AdminPrivileges.IsNull() {
-		attrs := r.AdminPrivileges.Elements()[0].(types.Object).Attributes()
+		attrs := r.AdminPrivileges.Attributes()
 		proj.AdminPrivileges.ManageMembers = attrs["manage_members"].(types.Bool).ValueBool()
 		proj.AdminPrivileges.ManageResources = attrs["manage_resources"].(types.Bool).ValueBool()
 		proj.AdminPrivileges.IndexResources = attrs["index_resources"].(types.Bool).ValueBool()
@@ -401,6 +552,47 @@ type ProjectAPIModel struct {
 	QuotaEmailNotification bool                    `json:"storage_quota_email_notification"`
 }
 
+const projectVersionPrivateKey = "project_version"
+
+// projectVersion is kept in the resource private state on refresh so updates can detect
+// changes made outside Terraform, e.g. in the UI, since the project was last read.
+type projectVersion struct {
+	ETag    string          `json:"etag,omitempty"`
+	Project ProjectAPIModel `json:"project"`
+}
+
+// Number of times a project read is retried when the payload is incomplete
+const incompleteProjectMaxRetries = 3
+
+// Fields that are always part of a complete project payload
+var requiredProjectFields = []string{
+	"project_key",
+	"admin_privileges",
+	"storage_quota_bytes",
+}
+
+// isIncompleteProject reports whether a successful project read is missing required fields,
+// which happens occasionally right after the project is written.
+func isIncompleteProject(body []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return true
+	}
+
+	return lo.SomeBy(requiredProjectFields, func(field string) bool {
+		value, ok := fields[field]
+		return !ok || string(value) == "null"
+	})
+}
+
+func retryOnIncompleteProject(resp *resty.Response, err error) bool {
+	if err != nil || resp == nil || !resp.IsSuccess() {
+		return false
+	}
+
+	return resp.Request.Attempt <= incompleteProjectMaxRetries && isIncompleteProject(resp.Body())
+}
+
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName
 }
@@ -419,7 +611,7 @@ var schemaV1 = schema.Schema{
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.RequiresReplace(),
 			},
-			Description: "The Project Key is added as a prefix to resources created within a Project. This field is mandatory and supports only 2 - 32 lowercase alphanumeric and hyphen characters. Must begin with a letter. For example: `us1a-test`.",
+			Description: "The Project Key is added as a prefix to resources created within a Project. This field is mandatory and supports only 2 - 32 lowercase alphanumeric and hyphen characters. Must begin with a letter. For example: `us1a-test`. Keys longer than 10 characters require Artifactory 7.56.2 or later.",
 		},
 		"display_name": schema.StringAttribute{
 			Required: true,
@@ -672,28 +864,184 @@ var schemaV3 = schema.Schema{
 	Description: schemaV2.Description,
 }
 
+var schemaV4 = schema.Schema{
+	Version: 4,
+	Attributes: lo.Assign(schemaV3.Attributes, map[string]schema.Attribute{
+		"use_project_repository_resource": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "When set to true, this resource will ignore the `repos` attributes and allow repository to be managed by `project_repository` resource instead. Default to `true`.",
+		},
+		"max_storage": schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.Any(
+					int64validator.AtLeast(1),
+					int64validator.OneOf(-1),
+				),
+				int64validator.ConflictsWith(path.MatchRoot("max_storage_in_gibibytes")),
+			},
+			Description: "Storage quota expressed in the unit set by `storage_quota_unit`. Must be 1 or larger. Set to -1 for unlimited storage. Conflicts with `max_storage_in_gibibytes`, which is computed from this value when set.",
+		},
+		"storage_quota_unit": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(defaultStorageQuotaUnit),
+			Validators: []validator.String{
+				stringvalidator.OneOf("MB", "GB", "TB"),
+			},
+			Description: "Unit of `max_storage`. Allowed values: `MB`, `GB`, `TB`. Units are binary, e.g. `1 TB` is translated to 1099511627776 bytes for the API. Default to `GB`.",
+		},
+		"wait_for_ready": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "When set to `true`, creation waits until the new project and its membership API respond successfully before finishing, so dependent resources don't race against the platform. Default to `false`.",
+		},
+		"poll_interval": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(defaultPollInterval),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			Description: fmt.Sprintf("Number of seconds between readiness checks when `wait_for_ready` is `true`. Default to `%d`.", defaultPollInterval),
+		},
+		"max_wait": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(defaultMaxWait),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			Description: fmt.Sprintf("Maximum number of seconds to wait for the project to become ready when `wait_for_ready` is `true`. The default `create` timeout is extended by the time above `%[1]d`. Default to `%[1]d`.", defaultMaxWait),
+		},
+		"repos": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+			Description:        "(Optional) List of existing repo keys to be assigned to the project. If you wish to use the alternate method of setting `project_key` attribute in each `artifactory_*_repository` resource in the `artifactory` provider, you will need to use `lifecycle.ignore_changes` in the `project` resource to avoid state drift.\n\n```hcl\nlifecycle {\n\tignore_changes = [\n\t\trepos\n\t]\n}\n```",
+			DeprecationMessage: "Replaced by `project_repository` resource. This should not be used in combination with `project_repository` resource. Use `use_project_repository_resource` attribute to control which resource manages project repositories.",
+		},
+	}),
+	Blocks:      schemaV3.Blocks,
+	Description: "Provides an Artifactory project resource. This can be used to create and manage Artifactory project, maintain users/groups/roles/repos.\n\n## Repository Configuration\n\nAfter the project configuration is applied with `repos` attribute set, the repository's attributes `project_key` and `project_environments` would be updated with the project's data. This will generate a state drift in the next Terraform plan/apply for the repository resource. To avoid this, apply `lifecycle.ignore_changes`:\n\n```hcl\nresource \"artifactory_local_maven_repository\" \"my_maven_releases\" {\n\tkey = \"my-maven-releases\"\n\t...\n\n\tlifecycle {\n\t\tignore_changes = [\n\t\t\tproject_environments,\n\t\t\tproject_key\n\t\t]\n\t}\n}\n```\n\n~>We strongly recommend using the `project_repository` resource instead to manage the list of repositories.",
+}
+
 func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version: 4,
-		Attributes: lo.Assign(schemaV3.Attributes, map[string]schema.Attribute{
-			"use_project_repository_resource": schema.BoolAttribute{
+		Version: 5,
+		Attributes: lo.Assign(schemaV4.Attributes, map[string]schema.Attribute{
+			"prevent_rename": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, plans changing `key` fail instead of replacing the project. The platform doesn't support renaming a project key, so a key change destroys the project, including its members, roles and environments, and creates a new one. Default to `false`.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, plans destroying or replacing the project fail. Destroying a project removes the role assignments, members and quota settings of every team using it. Set it to `false` and apply first if this is intended. Default to `false`.",
+			},
+			"force_delete_with_repos": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, all the repositories assigned to the project are detached from it before it is destroyed, including those not listed in `repos`, e.g. assigned with `project_repository` or outside of Terraform. The repositories themselves are not deleted. Otherwise the project can't be destroyed while repositories are assigned to it. Default to `false`.",
+			},
+			"force_move_repos": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
-				Description: "When set to true, this resource will ignore the `repos` attributes and allow repository to be managed by `project_repository` resource instead. Default to `true`.",
+				Description: "When set to `true`, repositories added to `repos` that are assigned to another project are moved to this project, and the plan warns about each move and the project it is moved from. When `false`, the plan fails for such repositories instead. Only applies when `use_project_repository_resource` is `false`. Default to `true`.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true` and a project with the same key already exists, e.g. created manually, the project is updated to match the configuration and managed by Terraform instead of failing the creation. Use with care as its settings, members, roles and repositories are overwritten. Default to `false`.",
+			},
+			"unmanaged_members_action": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(unmanagedMembersRemove),
+				Validators: []validator.String{
+					stringvalidator.OneOf(unmanagedMembersActions...),
+				},
+				Description: "How users and groups added to the project outside Terraform, e.g. platform admins added automatically, are handled. `remove` removes them on the next apply, `ignore` leaves them in the project and out of the state, `warn` does the same and reports them on every refresh. Only applies to `member` and `group`. Default to `remove`.",
+			},
+			"quota_warning_threshold": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				Description: "Percentage of the storage quota at which refreshing the project emits a warning, so operators are alerted before deployments are blocked. Usage is the sum of the storage summary of the project repositories, which requires admin permissions. The usage is only read, and `quota_exceeded` and `over_soft_limit` only set, when this is set.",
+			},
+			"enforce_quota_block_deployments": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("block_deployments_on_limit")),
+				},
+				Description: "When set to `true`, deployment of artifacts is blocked once the storage quota is exceeded, i.e. a hard limit. When `false`, the quota is a soft limit and only triggers notifications. Default to `false`.\n\n~>This setting only applies to self-hosted environment. See [Manage Storage Quotas](https://jfrog.com/help/r/jfrog-platform-administration-documentation/manage-storage-quotas).",
+			},
+			"block_deployments_on_limit": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("enforce_quota_block_deployments")),
+				},
+				Description:        "Block deployment of artifacts if storage quota is exceeded.\n\n~>This setting only applies to self-hosted environment. See [Manage Storage Quotas](https://jfrog.com/help/r/jfrog-platform-administration-documentation/manage-storage-quotas).",
+				DeprecationMessage: "Replaced by `enforce_quota_block_deployments`, which has the same meaning. Both are kept in sync.",
 			},
-			"repos": schema.SetAttribute{
-				ElementType: types.StringType,
+			"validate_quota_against_usage": schema.BoolAttribute{
 				Optional:    true,
-				Validators: []validator.Set{
-					setvalidator.SizeAtLeast(1),
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, plans lowering the storage quota of the project below its current storage usage fail, instead of blocking deployments as soon as they are applied. This reads the storage usage of the project when the quota is lowered. Default to `false`.",
+			},
+			"quota_exceeded": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "`true` when the storage usage of the project reached its storage quota as of the last refresh. Always `false` for unlimited storage, and null when `quota_warning_threshold` isn't set or the usage can't be read. Useful in `check` blocks.",
+			},
+			"over_soft_limit": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "`true` when the storage usage of the project reached `quota_warning_threshold` percent of its storage quota as of the last refresh. Always `false` for unlimited storage, and null when `quota_warning_threshold` isn't set or the usage can't be read. Useful in `check` blocks.",
+			},
+		}),
+		Blocks: lo.Assign(schemaV4.Blocks, map[string]schema.Block{
+			"timeouts": timeoutsBlock,
+			"admin_privileges": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"manage_members": schema.BoolAttribute{
+						Required:    true,
+						Description: "Allows the Project Admin to manage Platform users/groups as project members with different roles.",
+					},
+					"manage_resources": schema.BoolAttribute{
+						Required:    true,
+						Description: "Allows the Project Admin to manage resources - repositories, builds and Pipelines resources on the project level.",
+					},
+					"index_resources": schema.BoolAttribute{
+						Required:    true,
+						Description: "Enables a project admin to define the resources to be indexed by Xray",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
 				},
-				Description:        "(Optional) List of existing repo keys to be assigned to the project. If you wish to use the alternate method of setting `project_key` attribute in each `artifactory_*_repository` resource in the `artifactory` provider, you will need to use `lifecycle.ignore_changes` in the `project` resource to avoid state drift.\n\n```hcl\nlifecycle {\n\tignore_changes = [\n\t\trepos\n\t]\n}\n```",
-				DeprecationMessage: "Replaced by `project_repository` resource. This should not be used in combination with `project_repository` resource. Use `use_project_repository_resource` attribute to control which resource manages project repositories.",
 			},
 		}),
-		Blocks:      schemaV3.Blocks,
-		Description: "Provides an Artifactory project resource. This can be used to create and manage Artifactory project, maintain users/groups/roles/repos.\n\n## Repository Configuration\n\nAfter the project configuration is applied with `repos` attribute set, the repository's attributes `project_key` and `project_environments` would be updated with the project's data. This will generate a state drift in the next Terraform plan/apply for the repository resource. To avoid this, apply `lifecycle.ignore_changes`:\n\n```hcl\nresource \"artifactory_local_maven_repository\" \"my_maven_releases\" {\n\tkey = \"my-maven-releases\"\n\t...\n\n\tlifecycle {\n\t\tignore_changes = [\n\t\t\tproject_environments,\n\t\t\tproject_key\n\t\t]\n\t}\n}\n```\n\n~>We strongly recommend using the `project_repository` resource instead to manage the list of repositories.",
+		Description: schemaV4.Description,
 	}
 }
 
@@ -702,13 +1050,211 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+// ineffectiveAdminPrivileges lists admin_privileges combinations that the platform accepts
+// but doesn't act on, keyed by the privilege that has no effect on its own.
+var ineffectiveAdminPrivileges = map[string]struct {
+	requires string
+	reason   string
+}{
+	"index_resources": {
+		requires: "manage_resources",
+		reason:   "Project Admins can only select resources to be indexed by Xray when they can also manage the project resources.",
+	},
+}
+
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectResourceModelV5
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.AdminPrivileges.IsNull() || config.AdminPrivileges.IsUnknown() {
+		return
+	}
+
+	attrs := config.AdminPrivileges.Attributes()
+
+	for privilege, dependency := range ineffectiveAdminPrivileges {
+		enabled := attrs[privilege].(types.Bool)
+		required := attrs[dependency.requires].(types.Bool)
+		if enabled.IsUnknown() || required.IsUnknown() {
+			continue
+		}
+
+		if enabled.ValueBool() && !required.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("admin_privileges"),
+				"Ineffective admin privileges",
+				fmt.Sprintf("`%s` is set to `true` but `%s` is `false`. %s", privilege, dependency.requires, dependency.reason),
+			)
+		}
+	}
+}
+
+// ModifyPlan rejects plans that destroy a project protected by the provider configuration
+// or by `deletion_protection`,
+// warns about or rejects key changes, which replace the project, or use a key the platform
+// version doesn't support, checks member roles when the provider `validate_roles` is set,
+// and computes `max_storage_in_gibibytes` from `max_storage` and `storage_quota_unit` so both
+// attributes stay consistent with the quota sent to the API.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() {
+		var state ProjectResourceModelV5
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var planKey types.String
+		if !req.Plan.Raw.IsNull() {
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("key"), &planKey)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// A key change replaces the project so it is guarded like a destroy
+		if !planKey.IsUnknown() && planKey.ValueString() != state.Key.ValueString() && r.ProviderData.IsProtectedProjectKey(state.Key.ValueString()) {
+			resp.Diagnostics.AddError(
+				"Protected project",
+				fmt.Sprintf("Project '%s' is listed in the provider `protected_project_keys` and can't be destroyed or have its key changed. Remove it from `protected_project_keys` first if this is intended.", state.Key.ValueString()),
+			)
+			return
+		}
+
+		// The value in state applies, so the protection must be removed by a prior apply
+		if state.DeletionProtection.ValueBool() && (req.Plan.Raw.IsNull() || (!planKey.IsUnknown() && planKey.ValueString() != state.Key.ValueString())) {
+			resp.Diagnostics.AddError(
+				"Project deletion protected",
+				fmt.Sprintf("Project '%s' has `deletion_protection` set to `true` and can't be destroyed or have its key changed. Set `deletion_protection` to `false` and apply first if this is intended.", state.Key.ValueString()),
+			)
+			return
+		}
+
+		if !req.Plan.Raw.IsNull() && !planKey.IsUnknown() && planKey.ValueString() != state.Key.ValueString() {
+			var preventRename types.Bool
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("prevent_rename"), &preventRename)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if preventRename.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("key"),
+					"Project key change prevented",
+					fmt.Sprintf("Changing the key of project '%s' to '%s' replaces the project and `prevent_rename` is set to `true`. Set `prevent_rename` to `false` first if this is intended.", state.Key.ValueString(), planKey.ValueString()),
+				)
+				return
+			}
+
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("key"),
+				"Project key change replaces the project",
+				fmt.Sprintf("The platform doesn't support renaming a project key. Project '%s' will be destroyed, including its members, roles and environments, and project '%s' will be created. Repositories are unassigned and must be assigned again. Set `prevent_rename` to `true` to reject such plans.", state.Key.ValueString(), planKey.ValueString()),
+			)
+		}
+
+		if !req.Plan.Raw.IsNull() {
+			resp.Diagnostics.Append(membersHandoverWarnings(ctx, req.Plan, state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectResourceModelV5
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planBlockDeploymentsOnLimit(ctx, req.Config, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Key.IsUnknown() && len(plan.Key.ValueString()) > shortProjectKeyMaxLength {
+		resp.Diagnostics.Append(r.ProviderData.requireVersion(
+			path.Root("key"),
+			fmt.Sprintf("A project key longer than %d characters", shortProjectKeyMaxLength),
+			longProjectKeyMinVersion,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Key.IsUnknown() {
+		resp.Diagnostics.Append(r.validateMemberRoles(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.UseProjectRepositoryResource.ValueBool() && !plan.Key.IsUnknown() {
+		var stateRepos types.Set
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("repos"), &stateRepos)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		resp.Diagnostics.Append(r.repoMoveDiagnostics(ctx, plan, stateRepos)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.ValidateQuotaAgainstUsage.ValueBool() && !req.State.Raw.IsNull() {
+		var state ProjectResourceModelV5
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.validateQuotaAgainstUsage(ctx, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(planQuotaStatus(ctx, plan, req.State, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MaxStorage.IsNull() {
+		return
+	}
+
+	if plan.MaxStorage.IsUnknown() || plan.StorageQuotaUnit.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("max_storage_in_gibibytes"), types.Int64Unknown())...)
+		return
+	}
+
+	storageQuota, err := StorageQuotaToBytes(plan.MaxStorage.ValueInt64(), plan.StorageQuotaUnit.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_storage"), "Invalid storage quota", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("max_storage_in_gibibytes"), BytesToGibibytes(storageQuota))...)
 }
 
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan ProjectResourceModelV4
+	var plan ProjectResourceModelV5
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -716,6 +1262,19 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// Waiting longer than the default max_wait must not eat into the time left for the requests
+	createTimeoutDefault := defaultOperationTimeouts[createTimeout]
+	if plan.WaitForReady.ValueBool() && plan.MaxWait.ValueInt64() > defaultMaxWait {
+		createTimeoutDefault += time.Duration(plan.MaxWait.ValueInt64()-defaultMaxWait) * time.Second
+	}
+
+	ctx, cancel, ds := withTimeoutDefault(ctx, plan.Timeouts, createTimeout, createTimeoutDefault)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	var project ProjectAPIModel
 	var users []MemberAPIModel
 	var groups []MemberAPIModel
@@ -728,19 +1287,62 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetBody(project).
 		SetError(&projectError).
 		Post(ProjectsUrl)
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusConflict && plan.AdoptExisting.ValueBool() {
+		response, err = r.ProviderData.Client.R().
+			SetContext(ctx).
+			SetPathParam("projectKey", project.Key).
+			SetBody(project).
+			SetError(&projectError).
+			Put(ProjectUrl)
+		if err != nil {
+			utilfw.UnableToCreateResourceError(resp, err.Error())
+			return
+		}
+		if response.IsError() {
+			utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
+			return
+		}
+
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("adopt_existing"),
+			"Existing project adopted",
+			fmt.Sprintf("Project '%s' already exists and is now managed by Terraform. Its settings, members, roles and repositories were updated to match the configuration.", project.Key),
+		)
 	}
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	// backward compatibility
 	plan.ID = types.StringValue(project.Key)
 
+	// Nothing is managed yet, so members added by the platform on creation are kept
+	// unless unmanaged_members_action is set to remove
+	var previousUsers, previousGroups []MemberAPIModel
+
+	if plan.WaitForReady.ValueBool() {
+		err = waitForProjectReady(
+			ctx,
+			project.Key,
+			time.Duration(plan.PollInterval.ValueInt64())*time.Second,
+			time.Duration(plan.MaxWait.ValueInt64())*time.Second,
+			r.ProviderData.Client,
+		)
+		if err != nil {
+			utilfw.UnableToCreateResourceError(resp, err.Error())
+			return
+		}
+	}
+
 	if !plan.UseProjectRoleResource.ValueBool() {
 		_, err = updateRoles(ctx, project.Key, roles, r.ProviderData.Client)
 		if err != nil {
@@ -750,7 +1352,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.UseProjectUserResource.ValueBool() {
-		_, err = updateMembers(ctx, project.Key, usersMembershipType, users, r.ProviderData.Client)
+		_, err = updateMembers(ctx, project.Key, usersMembershipType, users, previousUsers, plan.UnmanagedMembersAction.ValueString(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToCreateResourceError(resp, err.Error())
 			return
@@ -758,7 +1360,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.UseProjectGroupResource.ValueBool() {
-		_, err = updateMembers(ctx, project.Key, groupsMembershipType, groups, r.ProviderData.Client)
+		_, err = updateMembers(ctx, project.Key, groupsMembershipType, groups, previousGroups, plan.UnmanagedMembersAction.ValueString(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToCreateResourceError(resp, err.Error())
 			return
@@ -766,33 +1368,84 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.UseProjectRepositoryResource.ValueBool() {
-		_, err = updateRepos(ctx, project.Key, repos, r.ProviderData.Client)
+		_, err = updateRepos(ctx, project.Key, repos, plan.ForceMoveRepos.ValueBool(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToCreateResourceError(resp, err.Error())
 			return
 		}
 	}
 
+	resp.Diagnostics.Append(plan.refreshQuotaStatus(ctx, project.StorageQuota, r.ProviderData.Client)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// waitForProjectReady polls the project and its membership API until both respond
+// successfully, or until maxWait has elapsed.
+func waitForProjectReady(ctx context.Context, projectKey string, pollInterval, maxWait time.Duration, client *resty.Client) error {
+	tflog.Debug(ctx, "waitForProjectReady", map[string]interface{}{
+		"project_key":   projectKey,
+		"poll_interval": pollInterval.String(),
+		"max_wait":      maxWait.String(),
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, maxWait)
+	defer cancel()
+
+	var retryFunc = func() error {
+		for _, url := range []string{ProjectUrl, projectMembershipsUrl} {
+			resp, err := client.R().
+				SetContext(waitCtx).
+				SetPathParams(map[string]string{
+					"projectKey":     projectKey,
+					"membershipType": usersMembershipType,
+				}).
+				Get(url)
+			if err != nil {
+				return err
+			}
+			if resp.IsError() {
+				return fmt.Errorf("project %s is not ready: %s returned %d", projectKey, resp.Request.URL, resp.StatusCode())
+			}
+		}
+
+		return nil
+	}
+
+	err := backoff.Retry(retryFunc, backoff.WithContext(backoff.NewConstantBackOff(pollInterval), waitCtx))
+	if err != nil {
+		return fmt.Errorf("timed out after %s waiting for project %s to become ready: %s", maxWait, projectKey, err)
+	}
+
+	return nil
+}
+
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var state ProjectResourceModelV4
+	var state ProjectResourceModelV5
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, state.Timeouts, readTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	var project ProjectAPIModel
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParam("projectKey", state.Key.ValueString()).
 		SetResult(&project).
 		SetError(&projectError).
+		AddRetryCondition(retryOnIncompleteProject).
 		Get(ProjectUrl)
 	if err != nil {
 		utilfw.UnableToRefreshResourceError(resp, err.Error())
@@ -803,7 +1456,12 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+	// Don't save an incomplete payload into state as it shows up as drift on the next plan
+	if isIncompleteProject(response.Body()) {
+		utilfw.UnableToRefreshResourceError(resp, fmt.Sprintf("incomplete project payload after %d retries: %s", incompleteProjectMaxRetries, response.String()))
 		return
 	}
 
@@ -817,7 +1475,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	groups := []MemberAPIModel{}
-	if !state.UseProjectUserResource.ValueBool() {
+	if !state.UseProjectGroupResource.ValueBool() {
 		groups, err = readMembers(ctx, state.Key.ValueString(), groupsMembershipType, r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToRefreshResourceError(resp, err.Error())
@@ -826,7 +1484,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	roles := []Role{}
-	if !state.UseProjectUserResource.ValueBool() {
+	if !state.UseProjectRoleResource.ValueBool() {
 		roles, err = readRoles(ctx, state.Key.ValueString(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToRefreshResourceError(resp, err.Error())
@@ -835,7 +1493,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	repos := []string{}
-	if !state.UseProjectUserResource.ValueBool() {
+	if !state.UseProjectRepositoryResource.ValueBool() {
 		repos, err = readRepos(ctx, state.Key.ValueString(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToRefreshResourceError(resp, err.Error())
@@ -843,6 +1501,40 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		}
 	}
 
+	// The flags are only null right after an import. Whatever the project already has is
+	// managed by this resource, so a configuration declaring all of it has no diff.
+	if state.UseProjectUserResource.IsNull() {
+		state.UseProjectUserResource = types.BoolValue(len(users) == 0)
+	}
+	if state.UseProjectGroupResource.IsNull() {
+		state.UseProjectGroupResource = types.BoolValue(len(groups) == 0)
+	}
+	if state.UseProjectRoleResource.IsNull() {
+		state.UseProjectRoleResource = types.BoolValue(len(roles) == 0)
+	}
+	if state.UseProjectRepositoryResource.IsNull() {
+		state.UseProjectRepositoryResource = types.BoolValue(len(repos) == 0)
+	}
+
+	if action := state.UnmanagedMembersAction.ValueString(); action == unmanagedMembersIgnore || action == unmanagedMembersWarn {
+		managedUsers, ds := resourceMemberToAPIModels(ctx, state.Members)
+		resp.Diagnostics.Append(ds...)
+		managedGroups, ds := resourceMemberToAPIModels(ctx, state.Groups)
+		resp.Diagnostics.Append(ds...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var unmanagedUsers, unmanagedGroups []MemberAPIModel
+		users, unmanagedUsers = partitionManagedMembers(users, managedUsers)
+		groups, unmanagedGroups = partitionManagedMembers(groups, managedGroups)
+
+		if action == unmanagedMembersWarn {
+			addUnmanagedMembersWarning(&resp.Diagnostics, path.Root("member"), state.Key.ValueString(), unmanagedUsers)
+			addUnmanagedMembersWarning(&resp.Diagnostics, path.Root("group"), state.Key.ValueString(), unmanagedGroups)
+		}
+	}
+
 	// Convert from the API data model to the Terraform data model
 	// and refresh any attribute values.
 	resp.Diagnostics.Append(state.fromAPIModel(ctx, project, users, groups, roles, repos)...)
@@ -850,6 +1542,18 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(state.refreshQuotaStatus(ctx, project.StorageQuota, r.ProviderData.Client)...)
+
+	version, err := json.Marshal(projectVersion{
+		ETag:    response.Header().Get("ETag"),
+		Project: project,
+	})
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, projectVersionPrivateKey, version)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -857,7 +1561,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan ProjectResourceModelV4
+	var plan ProjectResourceModelV5
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -865,6 +1569,29 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	var state ProjectResourceModelV5
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, ds := withTimeout(ctx, plan.Timeouts, updateTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	// Only members removed from the configuration are removed from the project
+	// unless unmanaged_members_action is set to remove
+	previousUsers, ds := resourceMemberToAPIModels(ctx, state.Members)
+	resp.Diagnostics.Append(ds...)
+	previousGroups, ds := resourceMemberToAPIModels(ctx, state.Groups)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var project ProjectAPIModel
 	var users []MemberAPIModel
 	var groups []MemberAPIModel
@@ -875,19 +1602,60 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	request := r.ProviderData.Client.R().
+		SetContext(ctx)
+
+	privateVersion, ds := req.Private.GetKey(ctx, projectVersionPrivateKey)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Not set when the state wasn't refreshed since it was created or last updated
+	if len(privateVersion) > 0 {
+		var version projectVersion
+		if err := json.Unmarshal(privateVersion, &version); err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+
+		if version.ETag != "" {
+			request.SetHeader("If-Match", version.ETag)
+		} else {
+			changed, err := isProjectChanged(ctx, version.Project, r.ProviderData.Client)
+			if err != nil {
+				utilfw.UnableToUpdateResourceError(resp, err.Error())
+				return
+			}
+			if changed {
+				addProjectChangedError(&resp.Diagnostics, project.Key)
+				return
+			}
+		}
+	}
+
 	var projectError ProjectErrorsResponse
-	response, err := r.ProviderData.Client.R().
+	response, err := request.
 		SetPathParam("projectKey", project.Key).
 		SetBody(project).
 		SetError(&projectError).
 		Put(ProjectUrl)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusPreconditionFailed {
+		addProjectChangedError(&resp.Diagnostics, project.Key)
+		return
 	}
 	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		utilfw.UnableToUpdateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
+	// The version is stale now, the next refresh reads it again
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, projectVersionPrivateKey, nil)...)
+
 	// backward compatibility
 	plan.ID = types.StringValue(project.Key)
 
@@ -900,7 +1668,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if !plan.UseProjectUserResource.ValueBool() {
-		_, err = updateMembers(ctx, project.Key, usersMembershipType, users, r.ProviderData.Client)
+		_, err = updateMembers(ctx, project.Key, usersMembershipType, users, previousUsers, plan.UnmanagedMembersAction.ValueString(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToUpdateResourceError(resp, err.Error())
 			return
@@ -908,7 +1676,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if !plan.UseProjectGroupResource.ValueBool() {
-		_, err = updateMembers(ctx, project.Key, groupsMembershipType, groups, r.ProviderData.Client)
+		_, err = updateMembers(ctx, project.Key, groupsMembershipType, groups, previousGroups, plan.UnmanagedMembersAction.ValueString(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToUpdateResourceError(resp, err.Error())
 			return
@@ -916,21 +1684,322 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if !plan.UseProjectRepositoryResource.ValueBool() {
-		_, err = updateRepos(ctx, project.Key, repos, r.ProviderData.Client)
+		_, err = updateRepos(ctx, project.Key, repos, plan.ForceMoveRepos.ValueBool(), r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToUpdateResourceError(resp, err.Error())
 			return
 		}
 	}
 
+	// Unknown when the prior state predates these attributes or quota_warning_threshold
+	// changed, otherwise kept from the last refresh
+	if plan.QuotaExceeded.IsUnknown() || plan.OverSoftLimit.IsUnknown() {
+		resp.Diagnostics.Append(plan.refreshQuotaStatus(ctx, project.StorageQuota, r.ProviderData.Client)...)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// isProjectChanged reads the project again and compares it to the version last read.
+func isProjectChanged(ctx context.Context, version ProjectAPIModel, client *resty.Client) (bool, error) {
+	var current ProjectAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := client.R().
+		SetContext(ctx).
+		SetPathParam("projectKey", version.Key).
+		SetResult(&current).
+		SetError(&projectError).
+		AddRetryCondition(retryOnIncompleteProject).
+		Get(ProjectUrl)
+	if err != nil {
+		return false, err
+	}
+	if response.IsError() {
+		return false, fmt.Errorf("%s", apiErrorMessage(response, projectError))
+	}
+
+	return current != version, nil
+}
+
+// validateMemberRoles checks the roles of the `member` and `group` blocks against the roles of
+// the project, including the custom roles of the `role` blocks which are created by the apply.
+func (r *ProjectResource) validateMemberRoles(ctx context.Context, plan ProjectResourceModelV5) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var pendingRoles []string
+	if !plan.UseProjectRoleResource.ValueBool() {
+		for _, elem := range plan.Roles.Elements() {
+			if name, ok := elem.(types.Object).Attributes()["name"].(types.String); ok && !name.IsUnknown() {
+				pendingRoles = append(pendingRoles, name.ValueString())
+			}
+		}
+	}
+
+	if !plan.UseProjectUserResource.ValueBool() {
+		diags.Append(r.ProviderData.validateRoleNames(ctx, path.Root("member"), plan.Key.ValueString(), memberRoleNames(plan.Members), pendingRoles)...)
+	}
+
+	if !plan.UseProjectGroupResource.ValueBool() {
+		diags.Append(r.ProviderData.validateRoleNames(ctx, path.Root("group"), plan.Key.ValueString(), memberRoleNames(plan.Groups), pendingRoles)...)
+	}
+
+	return diags
+}
+
+// membersHandoverWarnings warns when `use_project_user_resource` or `use_project_group_resource`
+// switches to `true`. The memberships are kept as is, and the warning lists the `import` blocks
+// adopting them in `project_user` and `project_group` resources, so the configuration can be
+// split without removing and re-adding any member.
+func membersHandoverWarnings(ctx context.Context, plan tfsdk.Plan, state ProjectResourceModelV5) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	handovers := []struct {
+		flag         string
+		block        string
+		resourceType string
+		wasManaged   bool
+		members      types.Set
+	}{
+		{"use_project_user_resource", "member", "project_user", !state.UseProjectUserResource.ValueBool(), state.Members},
+		{"use_project_group_resource", "group", "project_group", !state.UseProjectGroupResource.ValueBool(), state.Groups},
+	}
+
+	for _, handover := range handovers {
+		if !handover.wasManaged || len(handover.members.Elements()) == 0 {
+			continue
+		}
+
+		var useResource types.Bool
+		diags.Append(plan.GetAttribute(ctx, path.Root(handover.flag), &useResource)...)
+		if diags.HasError() {
+			return diags
+		}
+		if !useResource.ValueBool() {
+			continue
+		}
+
+		var names []string
+		for _, elem := range handover.members.Elements() {
+			name, ok := elem.(types.Object).Attributes()["name"].(types.String)
+			if ok {
+				names = append(names, name.ValueString())
+			}
+		}
+
+		diags.AddAttributeWarning(
+			path.Root(handover.flag),
+			fmt.Sprintf("Memberships handed over to %s", handover.resourceType),
+			fmt.Sprintf("The `%s` blocks of project '%s' are no longer managed by the project resource. The memberships are kept on the platform. Add the following `import` blocks next to the `%s` resources replacing them, so they are adopted instead of created:\n\n%s",
+				handover.block, state.Key.ValueString(), handover.resourceType, memberImportBlocks(handover.resourceType, state.Key.ValueString(), names)),
+		)
+	}
+
+	return diags
+}
+
+// repoMoveDiagnostics reports the repositories added to `repos` that are assigned to another
+// project: a warning when `force_move_repos` moves them, an error otherwise as the API would
+// reject them. Repositories whose project can't be read are skipped.
+func (r *ProjectResource) repoMoveDiagnostics(ctx context.Context, plan ProjectResourceModelV5, stateRepos types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	addedRepos, _ := lo.Difference(knownStrings(plan.Repos), knownStrings(stateRepos))
+
+	projectKey := plan.Key.ValueString()
+	var moves []string
+	for _, repoKey := range addedRepos {
+		repoProjectKey, err := readRepoProjectKey(ctx, repoKey, r.ProviderData.Client)
+		if err != nil {
+			tflog.Warn(ctx, "failed to read repository project", map[string]any{
+				"repoKey": repoKey,
+				"error":   err.Error(),
+			})
+			continue
+		}
+
+		if repoProjectKey != "" && repoProjectKey != projectKey {
+			moves = append(moves, fmt.Sprintf("%s (from project '%s')", repoKey, repoProjectKey))
+		}
+	}
+
+	if len(moves) == 0 {
+		return diags
+	}
+
+	if plan.ForceMoveRepos.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("repos"),
+			"Repositories will be moved from other projects",
+			fmt.Sprintf("The following repositories are assigned to another project and will be moved to project '%s': %s. Set `force_move_repos` to `false` to reject such plans.", projectKey, strings.Join(moves, ", ")),
+		)
+	} else {
+		diags.AddAttributeError(
+			path.Root("repos"),
+			"Repositories assigned to other projects",
+			fmt.Sprintf("The following repositories are assigned to another project and can't be assigned to project '%s': %s. Set `force_move_repos` to `true` to move them.", projectKey, strings.Join(moves, ", ")),
+		)
+	}
+
+	return diags
+}
+
+// planBlockDeploymentsOnLimit sets `enforce_quota_block_deployments` and its deprecated
+// `block_deployments_on_limit` to the value configured in either of them, `false` otherwise,
+// as both are sent as `soft_limit` to the API.
+func planBlockDeploymentsOnLimit(ctx context.Context, config tfsdk.Config, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var enforce, deprecated types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root("enforce_quota_block_deployments"), &enforce)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("block_deployments_on_limit"), &deprecated)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	blockDeployments := enforce
+	if blockDeployments.IsNull() {
+		blockDeployments = deprecated
+	}
+	if blockDeployments.IsNull() {
+		blockDeployments = types.BoolValue(false)
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("enforce_quota_block_deployments"), blockDeployments)...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("block_deployments_on_limit"), blockDeployments)...)
+
+	return diags
+}
+
+// planQuotaStatus plans `quota_exceeded` and `over_soft_limit` as null when
+// `quota_warning_threshold` isn't set, and as unknown when it changed so they are computed
+// again on apply instead of keeping the status of the previous threshold.
+func planQuotaStatus(ctx context.Context, plan ProjectResourceModelV5, state tfsdk.State, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status := types.BoolNull()
+	if !plan.QuotaWarningThreshold.IsNull() {
+		if state.Raw.IsNull() {
+			return diags
+		}
+
+		var stateThreshold types.Int64
+		diags.Append(state.GetAttribute(ctx, path.Root("quota_warning_threshold"), &stateThreshold)...)
+		if diags.HasError() || plan.QuotaWarningThreshold.Equal(stateThreshold) {
+			return diags
+		}
+
+		status = types.BoolUnknown()
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("quota_exceeded"), status)...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("over_soft_limit"), status)...)
+
+	return diags
+}
+
+// storageQuotaBytes returns the storage quota in bytes, -1 when unlimited, and whether it
+// is known
+func (r ProjectResourceModelV5) storageQuotaBytes() (int64, bool) {
+	if !r.MaxStorage.IsNull() {
+		if r.MaxStorage.IsUnknown() || r.StorageQuotaUnit.IsUnknown() {
+			return 0, false
+		}
+
+		storageQuota, err := StorageQuotaToBytes(r.MaxStorage.ValueInt64(), r.StorageQuotaUnit.ValueString())
+		return storageQuota, err == nil
+	}
+
+	if r.MaxStorageInGibibytes.IsUnknown() || r.MaxStorageInGibibytes.IsNull() {
+		return 0, false
+	}
+
+	return GibibytesToBytes(r.MaxStorageInGibibytes.ValueInt64()), true
+}
+
+// validateQuotaAgainstUsage rejects lowering the storage quota below the storage used by the
+// project. The usage is only read when the quota is lowered, and failing to read it is
+// reported as a warning so an unavailable storage summary doesn't block unrelated changes.
+func (r *ProjectResource) validateQuotaAgainstUsage(ctx context.Context, plan, state ProjectResourceModelV5) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planQuota, ok := plan.storageQuotaBytes()
+	// Unknown or unlimited
+	if !ok || planQuota <= 0 {
+		return diags
+	}
+
+	stateQuota, ok := state.storageQuotaBytes()
+	if ok && stateQuota > 0 && planQuota >= stateQuota {
+		return diags
+	}
+
+	usage, err := readStorageUsage(ctx, state.Key.ValueString(), r.ProviderData.Client)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to validate storage quota against usage",
+			fmt.Sprintf("Failed to read the storage usage of project '%s': %s", state.Key.ValueString(), err),
+		)
+		return diags
+	}
+
+	if usage > planQuota {
+		attrPath := path.Root("max_storage_in_gibibytes")
+		if !plan.MaxStorage.IsNull() {
+			attrPath = path.Root("max_storage")
+		}
+
+		diags.AddAttributeError(
+			attrPath,
+			"Storage quota below current usage",
+			fmt.Sprintf("Project '%s' uses %d bytes of storage, which is more than the planned quota of %d bytes. Deployments to the project would be blocked as soon as the quota is applied. Raise the quota, free up storage first, or set `validate_quota_against_usage` to `false`.", state.Key.ValueString(), usage, planQuota),
+		)
+	}
+
+	return diags
+}
+
+// memberRoleNames returns the known roles of the `member` or `group` blocks
+func memberRoleNames(members types.Set) []string {
+	var roles []string
+	for _, elem := range members.Elements() {
+		memberRoles, ok := elem.(types.Object).Attributes()["roles"].(types.Set)
+		if !ok {
+			continue
+		}
+
+		roles = append(roles, knownStrings(memberRoles)...)
+	}
+
+	return roles
+}
+
+func addProjectChangedError(diags *diag.Diagnostics, projectKey string) {
+	diags.AddError(
+		"Project changed outside Terraform",
+		fmt.Sprintf("Project '%s' was modified since Terraform last read it, e.g. in the UI. Refresh the state and review the plan again before applying, so these changes aren't overwritten.", projectKey),
+	)
+}
+
+func addUnmanagedMembersWarning(diags *diag.Diagnostics, attrPath path.Path, projectKey string, members []MemberAPIModel) {
+	if len(members) == 0 {
+		return
+	}
+
+	names := lo.Map(members, func(member MemberAPIModel, _ int) string {
+		return member.Name
+	})
+	diags.AddAttributeWarning(
+		attrPath,
+		"Unmanaged project members",
+		fmt.Sprintf("Project '%s' has members not managed by Terraform: %s. They are left in the project as `unmanaged_members_action` is set to `%s`.", projectKey, strings.Join(names, ", "), unmanagedMembersWarn),
+	)
+}
+
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var state ProjectResourceModelV4
+	var state ProjectResourceModelV5
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -938,12 +2007,47 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, state.Timeouts, deleteTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	// Also checked at plan time. This guards against the provider configuration changing
+	// between plan and apply.
+	if r.ProviderData.IsProtectedProjectKey(state.Key.ValueString()) {
+		utilfw.UnableToDeleteResourceError(resp, fmt.Sprintf("project '%s' is listed in the provider protected_project_keys", state.Key.ValueString()))
+		return
+	}
+	if state.DeletionProtection.ValueBool() {
+		utilfw.UnableToDeleteResourceError(resp, fmt.Sprintf("project '%s' has deletion_protection set to true", state.Key.ValueString()))
+		return
+	}
+
 	var repos []string
 	resp.Diagnostics.Append(state.Repos.ElementsAs(ctx, &repos, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if state.ForceDeleteWithRepos.ValueBool() {
+		assignedRepos, err := readRepos(ctx, state.Key.ValueString(), r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToDeleteResourceError(resp, fmt.Sprintf("failed to fetch repos for project: %s", err))
+			return
+		}
+
+		unmanagedRepos := lo.Without(assignedRepos, repos...)
+		for _, repoKey := range unmanagedRepos {
+			tflog.Info(ctx, "Detaching repository from project before deletion", map[string]interface{}{
+				"project_key": state.Key.ValueString(),
+				"repo_key":    repoKey,
+			})
+		}
+		repos = append(repos, unmanagedRepos...)
+	}
+
 	deleteErr := deleteRepos(ctx, repos, r.ProviderData.Client)
 	if deleteErr != nil {
 		utilfw.UnableToDeleteResourceError(resp, fmt.Sprintf("failed to delete repos for project: %s", deleteErr))
@@ -952,6 +2056,7 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParam("projectKey", state.Key.ValueString()).
 		SetError(&projectError).
 		AddRetryCondition(
@@ -966,7 +2071,7 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -981,7 +2086,7 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 
 func (r *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{
-		// State upgrade implementation from 1 (prior state version) to 4 (Schema.Version)
+		// State upgrade implementation from 1 (prior state version) to 5 (Schema.Version)
 		1: {
 			PriorSchema: &schemaV1,
 			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) { /* ... */
@@ -1012,10 +2117,10 @@ func (r *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.S
 					UseProjectRepositoryResource: types.BoolValue(false),
 				}
 
-				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+				resp.Diagnostics.Append(upgradeStateV4ToV5(ctx, upgradedStateData, resp)...)
 			},
 		},
-		// State upgrade implementation from 2 (prior state version) to 4 (Schema.Version)
+		// State upgrade implementation from 2 (prior state version) to 5 (Schema.Version)
 		2: {
 			PriorSchema: &schemaV2,
 			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) { /* ... */
@@ -1046,10 +2151,10 @@ func (r *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.S
 					UseProjectRepositoryResource: types.BoolValue(false),
 				}
 
-				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+				resp.Diagnostics.Append(upgradeStateV4ToV5(ctx, upgradedStateData, resp)...)
 			},
 		},
-		// State upgrade implementation from 2 (prior state version) to 4 (Schema.Version)
+		// State upgrade implementation from 3 (prior state version) to 5 (Schema.Version)
 		3: {
 			PriorSchema: &schemaV3,
 			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) { /* ... */
@@ -1080,8 +2185,69 @@ func (r *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.S
 					UseProjectRepositoryResource: types.BoolValue(false),
 				}
 
-				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+				resp.Diagnostics.Append(upgradeStateV4ToV5(ctx, upgradedStateData, resp)...)
+			},
+		},
+		// State upgrade implementation from 4 (prior state version) to 5 (Schema.Version)
+		4: {
+			PriorSchema: &schemaV4,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData ProjectResourceModelV4
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(upgradeStateV4ToV5(ctx, priorStateData, resp)...)
 			},
 		},
 	}
 }
+
+// upgradeStateV4ToV5 converts the single element admin_privileges set into an object,
+// copies block_deployments_on_limit to enforce_quota_block_deployments, and saves the
+// upgraded state.
+func upgradeStateV4ToV5(ctx context.Context, priorStateData ProjectResourceModelV4, resp *resource.UpgradeStateResponse) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	adminPrivileges := types.ObjectNull(adminPrivilegesAttrType)
+	if elems := priorStateData.AdminPrivileges.Elements(); len(elems) > 0 {
+		ap, d := types.ObjectValue(adminPrivilegesAttrType, elems[0].(types.Object).Attributes())
+		if d.HasError() {
+			ds.Append(d...)
+			return ds
+		}
+		adminPrivileges = ap
+	}
+
+	upgradedStateData := ProjectResourceModelV5{
+		ID:                           priorStateData.ID,
+		Key:                          priorStateData.Key,
+		DisplayName:                  priorStateData.DisplayName,
+		Description:                  priorStateData.Description,
+		AdminPrivileges:              adminPrivileges,
+		MaxStorageInGibibytes:        priorStateData.MaxStorageInGibibytes,
+		SoftLimit:                    priorStateData.SoftLimit,
+		EnforceQuotaBlockDeployments: priorStateData.SoftLimit,
+		QuotaEmailNotification:       priorStateData.QuotaEmailNotification,
+		Members:                      priorStateData.Members,
+		Groups:                       priorStateData.Groups,
+		Roles:                        priorStateData.Roles,
+		Repos:                        priorStateData.Repos,
+		UseProjectRoleResource:       priorStateData.UseProjectRoleResource,
+		UseProjectUserResource:       priorStateData.UseProjectUserResource,
+		UseProjectGroupResource:      priorStateData.UseProjectGroupResource,
+		UseProjectRepositoryResource: priorStateData.UseProjectRepositoryResource,
+		MaxStorage:                   priorStateData.MaxStorage,
+		StorageQuotaUnit:             priorStateData.StorageQuotaUnit,
+		WaitForReady:                 priorStateData.WaitForReady,
+		PollInterval:                 priorStateData.PollInterval,
+		MaxWait:                      priorStateData.MaxWait,
+		Timeouts:                     types.ObjectNull(timeoutsAttrTypes),
+	}
+
+	ds.Append(resp.State.Set(ctx, upgradedStateData)...)
+
+	return ds
+}
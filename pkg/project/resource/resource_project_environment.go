@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 
@@ -29,7 +30,7 @@ func NewProjectEnvironmentResource() resource.Resource {
 }
 
 type ProjectEnvironmentResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
@@ -85,7 +86,7 @@ func (r *ProjectEnvironmentResource) Configure(ctx context.Context, req resource
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
 }
 
 func (r *ProjectEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -116,7 +117,7 @@ func (r *ProjectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -149,8 +150,13 @@ func (r *ProjectEnvironmentResource) Read(ctx context.Context, req resource.Read
 		utilfw.UnableToRefreshResourceError(resp, err.Error())
 		return
 	}
+	// the project was deleted outside Terraform, the environment was deleted with it
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -211,7 +217,7 @@ func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		utilfw.UnableToUpdateResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -248,7 +254,7 @@ func (r *ProjectEnvironmentResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -216,3 +216,55 @@ func verifyEnvironment(projectKey, id string, request *resty.Request) (*resty.Re
 
 	return resp, nil
 }
+
+func TestAccProjectEnvironment_project_deleted_outside_terraform(t *testing.T) {
+	name := strings.ToLower(acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project_environment.%s", name)
+
+	params := map[string]any{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectEnvironment", `
+		resource "project" "{{ .project_key }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_key }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_environment" "{{ .name }}" {
+			name        = "{{ .name }}"
+			project_key = project.{{ .project_key }}.key
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceName, "name", name),
+			},
+			{
+				PreConfig: func() {
+					_, err := acctest.GetTestResty(t).R().
+						SetPathParam("projectKey", projectKey).
+						Delete(project.ProjectUrl)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,305 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+// Member types of a global role assignment, mapped to their membership API type
+var globalRoleAssignmentMemberTypes = map[string]string{
+	"user":  usersMembershipType,
+	"group": groupsMembershipType,
+}
+
+// memberLocks serializes the changes to the roles of a member, so several roles assigned to
+// the same user or group in one apply don't overwrite each other.
+var memberLocks sync.Map
+
+func lockMember(projectKey, membershipType, name string) func() {
+	lock, _ := memberLocks.LoadOrStore(fmt.Sprintf("%s/%s/%s", projectKey, membershipType, name), &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+func NewProjectGlobalRoleAssignmentResource() resource.Resource {
+	return &ProjectGlobalRoleAssignmentResource{
+		TypeName: "project_global_role_assignment",
+	}
+}
+
+type ProjectGlobalRoleAssignmentResource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectGlobalRoleAssignmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Role       types.String `tfsdk:"role"`
+	MemberType types.String `tfsdk:"member_type"`
+	Name       types.String `tfsdk:"name"`
+}
+
+func (m ProjectGlobalRoleAssignmentResourceModel) membershipType() string {
+	return globalRoleAssignmentMemberTypes[m.MemberType.ValueString()]
+}
+
+func (m *ProjectGlobalRoleAssignmentResourceModel) setID() {
+	m.ID = types.StringValue(strings.Join([]string{
+		m.ProjectKey.ValueString(),
+		m.MemberType.ValueString(),
+		m.Name.ValueString(),
+		m.Role.ValueString(),
+	}, ":"))
+}
+
+func (r *ProjectGlobalRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectGlobalRoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project in which the role applies.",
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The name of the global role, defined by a Platform Admin for all projects, e.g. an auditor role.",
+			},
+			"member_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "group"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Whether the role is granted to a `user` or a `group`.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The name of the user or group the role is granted to.",
+			},
+		},
+		Description: "Grant a global role to a user or group inside a project, e.g. to apply a global auditor role in a single project. The role is added to the roles of the member in the project, which becomes a member if it isn't already, and removed on destroy. Other roles of the member are kept, so several roles can be granted to the same member with one resource each. Don't combine it with `project_user`, `project_group`, `member` or `group` for the same member, as those manage all its roles. Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_members` is enabled.",
+	}
+}
+
+func (r *ProjectGlobalRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+// ModifyPlan checks the role when the provider `validate_roles` is set
+func (r *ProjectGlobalRoleAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectGlobalRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsUnknown() || plan.Role.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ProviderData.validateRoleNames(ctx, path.Root("role"), plan.ProjectKey.ValueString(), []string{plan.Role.ValueString()}, nil)...)
+}
+
+func (r *ProjectGlobalRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectGlobalRoleAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+	membershipType := plan.membershipType()
+	name := plan.Name.ValueString()
+	role := plan.Role.ValueString()
+
+	unlock := lockMember(projectKey, membershipType, name)
+	defer unlock()
+
+	member, err := readMember(ctx, projectKey, membershipType, name, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	if member == nil {
+		member = &MemberAPIModel{Name: name}
+	}
+
+	if !slices.Contains(member.Roles, role) {
+		member.Roles = append(member.Roles, role)
+		if err := updateMember(ctx, projectKey, membershipType, *member, r.ProviderData.Client); err != nil {
+			utilfw.UnableToCreateResourceError(resp, err.Error())
+			return
+		}
+	}
+
+	plan.setID()
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectGlobalRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectGlobalRoleAssignmentResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := readMember(ctx, state.ProjectKey.ValueString(), state.membershipType(), state.Name.ValueString(), r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// The member left the project or lost the role outside Terraform, so it is granted again
+	if member == nil || !slices.Contains(member.Roles, state.Role.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setID()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called as every attribute requires a replacement
+func (r *ProjectGlobalRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectGlobalRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectGlobalRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectGlobalRoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := state.ProjectKey.ValueString()
+	membershipType := state.membershipType()
+	name := state.Name.ValueString()
+
+	unlock := lockMember(projectKey, membershipType, name)
+	defer unlock()
+
+	member, err := readMember(ctx, projectKey, membershipType, name, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+	if member == nil {
+		return
+	}
+
+	// The membership API requires at least one role, the member is removed with its last one
+	member.Roles = lo.Without(member.Roles, state.Role.ValueString())
+	if len(member.Roles) == 0 {
+		err = deleteMember(ctx, projectKey, membershipType, *member, r.ProviderData.Client)
+	} else {
+		err = updateMember(ctx, projectKey, membershipType, *member, r.ProviderData.Client)
+	}
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectGlobalRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 4)
+	if len(parts) != 4 || lo.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected project_key:member_type:name:role",
+		)
+		return
+	}
+	if _, ok := globalRoleAssignmentMemberTypes[parts[1]]; !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("member_type must be 'user' or 'group', got '%s'", parts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), parts[3])...)
+}
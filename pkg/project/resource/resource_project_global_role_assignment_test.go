@@ -0,0 +1,117 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectGlobalRoleAssignment_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	username := fmt.Sprintf("tftestuser%s", strings.ToLower(acctest.RandSeq(5)))
+	resourceName := "project_global_role_assignment.viewer"
+
+	template := `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .username }}@tempurl.org"
+			password = "Password!123"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_global_role_assignment" "viewer" {
+			project_key = project.{{ .project_name }}.key
+			role        = "Viewer"
+			member_type = "user"
+			name        = artifactory_managed_user.{{ .username }}.name
+		}
+
+		{{ if .with_developer }}
+		resource "project_global_role_assignment" "developer" {
+			project_key = project.{{ .project_name }}.key
+			role        = "Developer"
+			member_type = "user"
+			name        = artifactory_managed_user.{{ .username }}.name
+		}
+		{{ end }}
+	`
+
+	params := map[string]interface{}{
+		"project_name":   projectName,
+		"project_key":    projectKey,
+		"username":       username,
+		"with_developer": true,
+	}
+	config := util.ExecuteTemplate("TestAccProjectGlobalRoleAssignment", template, params)
+
+	params["with_developer"] = false
+	configUpdated := util.ExecuteTemplate("TestAccProjectGlobalRoleAssignment", template, params)
+
+	// Reads the roles of the user once the assignments are applied
+	withMembership := func(config string) string {
+		return config + fmt.Sprintf(`
+			data "project_user" "member" {
+				project_key = project.%s.key
+				name        = artifactory_managed_user.%s.name
+				depends_on  = [project_global_role_assignment.viewer]
+			}
+		`, projectName, username)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:user:%s:Viewer", projectKey, username)),
+					resource.TestCheckResourceAttr(resourceName, "role", "Viewer"),
+					resource.TestCheckResourceAttr("project_global_role_assignment.developer", "role", "Developer"),
+				),
+			},
+			{
+				Config: withMembership(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.project_user.member", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.project_user.member", "roles.*", "Viewer"),
+					resource.TestCheckTypeSetElemAttr("data.project_user.member", "roles.*", "Developer"),
+				),
+			},
+			{
+				// Removing an assignment keeps the other roles of the user
+				Config: withMembership(configUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.project_user.member", "is_member", "true"),
+					resource.TestCheckResourceAttr("data.project_user.member", "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.project_user.member", "roles.*", "Viewer"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:user:%s:Viewer", projectKey, username),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,6 +21,7 @@ import (
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
 )
 
 const ProjectGroupsUrl = "access/api/v1/projects/{projectKey}/groups/{name}"
@@ -29,17 +33,25 @@ func NewProjectGroupResource() resource.Resource {
 }
 
 type ProjectGroupResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
 type ProjectGroupResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	ProjectKey types.String `tfsdk:"project_key"`
-	Roles      types.Set    `tfsdk:"roles"`
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	ProjectKey             types.String `tfsdk:"project_key"`
+	Roles                  types.Set    `tfsdk:"roles"`
+	AllowLastAdminRemoval  types.Bool   `tfsdk:"allow_last_admin_removal"`
+	IgnoreServerAddedRoles types.Set    `tfsdk:"ignore_server_added_roles"`
+	CreateGroupIfMissing   types.Bool   `tfsdk:"create_group_if_missing"`
+	DeleteGroupOnDestroy   types.Bool   `tfsdk:"delete_group_on_destroy"`
+	Timeouts               types.Object `tfsdk:"timeouts"`
 }
 
+// Private state key set when the platform group was created by the resource
+const groupCreatedPrivateKey = "group_created"
+
 type ProjectGroupAPIModel struct {
 	Name  string   `json:"name"`
 	Roles []string `json:"roles"`
@@ -81,6 +93,35 @@ func (r *ProjectGroupResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 				Description: "List of pre-defined Project or custom roles. Must have at least 1 role, e.g. 'Viewer'",
 			},
+			"allow_last_admin_removal": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, the group can be removed from the project, or have the 'Project Admin' role removed from its roles, even if it is the last member holding that role. Default to `false`.",
+			},
+			"ignore_server_added_roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				Description: "List of roles that the platform may add to the group on its own, e.g. default roles on admin groups. These roles are not reported as drift when they are returned by the API but are not in `roles`.",
+			},
+			"create_group_if_missing": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, the platform group is created before it is added to the project if it doesn't exist, so simple setups don't need the `artifactory` provider to manage the group. A group created this way is deleted again if adding it to the project fails. Default to `false`.",
+			},
+			"delete_group_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, the platform group is deleted when the resource is destroyed, if it was created because of `create_group_if_missing`. Groups that existed before are never deleted. Default to `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock,
 		},
 		Description: "Add a group as project member. Element has one to one mapping with the [JFrog Project Groups API](https://jfrog.com/help/r/jfrog-rest-apis/update-group-in-project). Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
 	}
@@ -91,7 +132,39 @@ func (r *ProjectGroupResource) Configure(ctx context.Context, req resource.Confi
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+// ModifyPlan checks the roles when the provider `validate_roles` is set
+func (r *ProjectGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProjectKey.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ProviderData.validateRoleNames(ctx, path.Root("roles"), plan.ProjectKey.ValueString(), knownStrings(plan.Roles), nil)...)
+}
+
+// checkLastAdminRemoval prevents the group from losing the Project Admin role held in state,
+// by being removed (nil roles) or by having its roles updated, if it is the last member holding it
+func (r *ProjectGroupResource) checkLastAdminRemoval(ctx context.Context, state ProjectGroupResourceModel, roles []string, allowLastAdminRemoval bool) diag.Diagnostics {
+	if allowLastAdminRemoval {
+		return nil
+	}
+
+	var previousRoles []string
+	ds := state.Roles.ElementsAs(ctx, &previousRoles, false)
+	if ds.HasError() {
+		return ds
+	}
+
+	return append(ds, checkLastAdminRemoval(ctx, state.ProjectKey.ValueString(), groupsMembershipType, state.Name.ValueString(), previousRoles, roles, r.ProviderData.Client)...)
 }
 
 func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -105,6 +178,13 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, plan.Timeouts, createTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	projectKey := plan.ProjectKey.ValueString()
 
 	var roles []string
@@ -118,20 +198,50 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 		Roles: roles,
 	}
 
+	if plan.CreateGroupIfMissing.ValueBool() {
+		created, err := createGroupIfMissing(ctx, group.Name, r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToCreateResourceError(resp, err.Error())
+			return
+		}
+		if created {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, groupCreatedPrivateKey, []byte("true"))...)
+
+			// The private state is discarded along with a failed resource, so the group
+			// wouldn't be deleted on destroy. Delete it now instead of leaving it behind.
+			defer func() {
+				if !resp.Diagnostics.HasError() {
+					return
+				}
+
+				if err := deleteGroup(ctx, group.Name, r.ProviderData.Client); err != nil {
+					resp.Diagnostics.AddWarning(
+						"Unable to delete created group",
+						fmt.Sprintf("Group '%s' was created for project '%s' but could not be deleted after the membership failed: %s", group.Name, projectKey, err),
+					)
+				}
+			}()
+		}
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       plan.Name.ValueString(),
 		}).
 		SetBody(group).
 		SetError(&projectError).
+		AddRetryCondition(retryOnMemberNotFound).
 		Put(ProjectGroupsUrl)
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
 	}
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, group.Name))
@@ -150,11 +260,19 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, state.Timeouts, readTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	projectKey := state.ProjectKey.ValueString()
 
 	var group ProjectGroupAPIModel
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       state.Name.ValueString(),
@@ -171,20 +289,37 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
 	state.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, group.Name))
 	state.Name = types.StringValue(group.Name)
 	state.ProjectKey = types.StringValue(projectKey)
-	roles, ds := types.SetValueFrom(ctx, types.StringType, group.Roles)
+
+	groupRoles, ds := filterServerAddedRoles(ctx, state, group.Roles)
+	if ds.HasError() {
+		resp.Diagnostics.Append(ds...)
+		return
+	}
+
+	roles, ds := types.SetValueFrom(ctx, types.StringType, groupRoles)
 	if ds.HasError() {
 		resp.Diagnostics.Append(ds...)
 		return
 	}
 	state.Roles = roles
 
+	if state.AllowLastAdminRemoval.IsNull() {
+		state.AllowLastAdminRemoval = types.BoolValue(false)
+	}
+	if state.CreateGroupIfMissing.IsNull() {
+		state.CreateGroupIfMissing = types.BoolValue(false)
+	}
+	if state.DeleteGroupOnDestroy.IsNull() {
+		state.DeleteGroupOnDestroy = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -192,14 +327,22 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan ProjectGroupResourceModel
+	var plan, state ProjectGroupResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, plan.Timeouts, updateTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	projectKey := plan.ProjectKey.ValueString()
 
 	var roles []string
@@ -213,20 +356,44 @@ func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		Roles: roles,
 	}
 
+	// a renamed group is added as a new member, the previous one keeps its roles
+	if state.Name.ValueString() == group.Name {
+		resp.Diagnostics.Append(r.checkLastAdminRemoval(ctx, state, roles, plan.AllowLastAdminRemoval.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The group may have been deleted since, or the flag set after creation
+	if plan.CreateGroupIfMissing.ValueBool() {
+		created, err := createGroupIfMissing(ctx, group.Name, r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+		if created {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, groupCreatedPrivateKey, []byte("true"))...)
+		}
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       plan.Name.ValueString(),
 		}).
 		SetBody(group).
 		SetError(&projectError).
+		AddRetryCondition(retryOnMemberNotFound).
 		Put(ProjectGroupsUrl)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
 	}
 	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		utilfw.UnableToUpdateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, group.Name))
@@ -246,10 +413,23 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, state.Timeouts, deleteTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	projectKey := state.ProjectKey.ValueString()
 
+	resp.Diagnostics.Append(r.checkLastAdminRemoval(ctx, state, nil, state.AllowLastAdminRemoval.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       state.Name.ValueString(),
@@ -261,14 +441,58 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
+	if state.DeleteGroupOnDestroy.ValueBool() {
+		groupCreated, ds := req.Private.GetKey(ctx, groupCreatedPrivateKey)
+		resp.Diagnostics.Append(ds...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if string(groupCreated) == "true" {
+			if err := deleteGroup(ctx, state.Name.ValueString(), r.ProviderData.Client); err != nil {
+				utilfw.UnableToDeleteResourceError(resp, err.Error())
+				return
+			}
+		}
+	}
+
 	// If the logic reaches here, it implicitly succeeded and will remove
 	// the resource from state if there are no other errors.
 }
 
+// filterServerAddedRoles drops roles listed in ignore_server_added_roles from the roles
+// returned by the API, unless they are also configured in roles, so the platform adding
+// them on its own doesn't show up as drift.
+func filterServerAddedRoles(ctx context.Context, state ProjectGroupResourceModel, apiRoles []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if state.IgnoreServerAddedRoles.IsNull() || state.IgnoreServerAddedRoles.IsUnknown() {
+		return apiRoles, diags
+	}
+
+	var ignoredRoles []string
+	diags.Append(state.IgnoreServerAddedRoles.ElementsAs(ctx, &ignoredRoles, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var stateRoles []string
+	if !state.Roles.IsNull() && !state.Roles.IsUnknown() {
+		diags.Append(state.Roles.ElementsAs(ctx, &stateRoles, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return lo.Filter(apiRoles, func(role string, _ int) bool {
+		return !slices.Contains(ignoredRoles, role) || slices.Contains(stateRoles, role)
+	}), diags
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *ProjectGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, ":", 2)
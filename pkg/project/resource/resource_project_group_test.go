@@ -2,12 +2,14 @@ package project_test
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
 	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
@@ -46,11 +48,17 @@ func TestAccProjectGroup_UpgradeFromSDKv2(t *testing.T) {
 			project_key = project.{{ .project_name }}.key
 			name = artifactory_group.{{ .group }}.name
 			roles = {{ .roles }}
+			{{ if .allow_last_admin_removal }}
+			allow_last_admin_removal = true
+			{{ end }}
 		}
 	`
 
 	config := util.ExecuteTemplate("TestAccProjectGroup", template, params)
 
+	params["allow_last_admin_removal"] = "true"
+	configAllowLastAdminRemoval := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -83,6 +91,16 @@ func TestAccProjectGroup_UpgradeFromSDKv2(t *testing.T) {
 				PlanOnly:                 true,
 				ConfigPlanChecks:         testutil.ConfigPlanChecks(fqrn),
 			},
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"artifactory": {
+						Source: "jfrog/artifactory",
+					},
+				},
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   configAllowLastAdminRemoval,
+				Check:                    resource.TestCheckResourceAttr(fqrn, "allow_last_admin_removal", "true"),
+			},
 		},
 	})
 }
@@ -174,6 +192,146 @@ func TestAccProjectGroup_full(t *testing.T) {
 	})
 }
 
+func TestAccProjectGroup_ignore_server_added_roles(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, fqrn, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+	}
+
+	template := `
+		resource "artifactory_group" "{{ .group }}" {
+			name = "{{ .group }}"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_group.{{ .group }}.name
+			roles = ["Developer"]
+			ignore_server_added_roles = ["Viewer"]
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(fqrn, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyProjectGroup(groupName, projectKey, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "roles.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "roles.0", "Developer"),
+					resource.TestCheckResourceAttr(fqrn, "ignore_server_added_roles.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "ignore_server_added_roles.0", "Viewer"),
+				),
+			},
+			{
+				Config:           config,
+				PlanOnly:         true,
+				ConfigPlanChecks: testutil.ConfigPlanChecks(fqrn),
+			},
+		},
+	})
+}
+
+func TestAccProjectGroup_createGroupIfMissing(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, fqrn, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+	}
+
+	template := `
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = "{{ .group }}"
+			roles = ["Developer"]
+			create_group_if_missing = true
+			delete_group_on_destroy = true
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			acctest.VerifyDeleted(fqrn, func(id string, request *resty.Request) (*resty.Response, error) {
+				return verifyProjectGroup(groupName, projectKey, request)
+			}),
+			func(_ *terraform.State) error {
+				resp, err := acctest.GetTestResty(t).R().
+					SetPathParam("name", groupName).
+					Get("/access/api/v2/groups/{name}")
+				if err != nil {
+					return err
+				}
+				if resp.StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("error: group %s still exists", groupName)
+				}
+				return nil
+			},
+		),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", groupName),
+					resource.TestCheckResourceAttr(fqrn, "roles.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "create_group_if_missing", "true"),
+					resource.TestCheckResourceAttr(fqrn, "delete_group_on_destroy", "true"),
+				),
+			},
+			{
+				Config:           config,
+				PlanOnly:         true,
+				ConfigPlanChecks: testutil.ConfigPlanChecks(fqrn),
+			},
+		},
+	})
+}
+
 func TestAccProjectGroup_invalid_roles(t *testing.T) {
 	_, _, projectName := testutil.MkNames("test-project-", "project")
 	_, fqrn, groupName := testutil.MkNames("test-project-group-", "project_group")
@@ -231,6 +389,148 @@ func TestAccProjectGroup_invalid_roles(t *testing.T) {
 	})
 }
 
+func TestAccProjectGroup_createGroupIfMissing_membershipFails(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, fqrn, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+	}
+
+	template := `
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = "{{ .group }}"
+			roles = ["tftest-unknown-role"]
+			create_group_if_missing = true
+			delete_group_on_destroy = true
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			acctest.VerifyDeleted(fqrn, func(id string, request *resty.Request) (*resty.Response, error) {
+				return verifyProjectGroup(groupName, projectKey, request)
+			}),
+			// the group created for the failed membership must not be left behind
+			func(_ *terraform.State) error {
+				resp, err := acctest.GetTestResty(t).R().
+					SetPathParam("name", groupName).
+					Get("/access/api/v2/groups/{name}")
+				if err != nil {
+					return err
+				}
+				if resp.StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("error: group %s still exists", groupName)
+				}
+				return nil
+			},
+		),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Unable to Create Resource.*`),
+			},
+		},
+	})
+}
+
+func TestAccProjectGroup_lastAdminRoleRemoval(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, fqrn, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+		"roles":        `["Developer", "Project Admin"]`,
+	}
+
+	template := `
+		resource "artifactory_group" "{{ .group }}" {
+			name = "{{ .group }}"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_group.{{ .group }}.name
+			roles = {{ .roles }}
+			{{ if .allow_last_admin_removal }}
+			allow_last_admin_removal = true
+			{{ end }}
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	params["roles"] = `["Developer"]`
+	configWithoutAdmin := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	params["allow_last_admin_removal"] = true
+	configAllowLastAdminRemoval := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(fqrn, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyProjectGroup(groupName, projectKey, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "roles.#", "2"),
+			},
+			{
+				Config:      configWithoutAdmin,
+				ExpectError: regexp.MustCompile(`.*Unable to remove last Project Admin.*`),
+			},
+			{
+				Config: configAllowLastAdminRemoval,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "roles.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "roles.0", "Developer"),
+				),
+			},
+		},
+	})
+}
+
 func verifyProjectGroup(name, projectKey string, request *resty.Request) (*resty.Response, error) {
 	return request.
 		SetPathParams(map[string]string{
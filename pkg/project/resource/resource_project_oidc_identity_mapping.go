@@ -0,0 +1,479 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+const (
+	oidcIdentityMappingsUrl = "/access/api/v1/oidc/{providerName}/identity_mappings"
+	oidcIdentityMappingUrl  = oidcIdentityMappingsUrl + "/{name}"
+)
+
+// Prefix of the token scope granting roles in a project, followed by the project key and
+// the comma separated roles
+const projectRolesScopePrefix = "applied-permissions/roles:"
+
+func NewProjectOIDCIdentityMappingResource() resource.Resource {
+	return &ProjectOIDCIdentityMappingResource{
+		TypeName: "project_oidc_identity_mapping",
+	}
+}
+
+type ProjectOIDCIdentityMappingResource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectOIDCIdentityMappingResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProjectKey   types.String `tfsdk:"project_key"`
+	ProviderName types.String `tfsdk:"provider_name"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Priority     types.Int64  `tfsdk:"priority"`
+	ClaimsJSON   types.String `tfsdk:"claims_json"`
+	Roles        types.Set    `tfsdk:"roles"`
+	Username     types.String `tfsdk:"username"`
+	Audience     types.String `tfsdk:"audience"`
+	ExpiresIn    types.Int64  `tfsdk:"expires_in"`
+}
+
+type OIDCIdentityMappingAPIModel struct {
+	Name        string                       `json:"name"`
+	Description string                       `json:"description,omitempty"`
+	Priority    int64                        `json:"priority"`
+	Claims      map[string]any               `json:"claims"`
+	TokenSpec   OIDCIdentityMappingTokenSpec `json:"token_spec"`
+	ProjectKey  string                       `json:"project_key,omitempty"`
+}
+
+type OIDCIdentityMappingTokenSpec struct {
+	Username  string `json:"username,omitempty"`
+	Scope     string `json:"scope"`
+	Audience  string `json:"audience,omitempty"`
+	ExpiresIn int64  `json:"expires_in,omitempty"`
+}
+
+// projectRolesScope returns the token scope granting the roles in the project
+func projectRolesScope(projectKey string, roles []string) string {
+	roles = slices.Clone(roles)
+	slices.Sort(roles)
+	return fmt.Sprintf("%s%s:%s", projectRolesScopePrefix, projectKey, strings.Join(roles, ","))
+}
+
+// parseProjectRolesScope returns the roles granted by the scope in the project, or nil when
+// the scope isn't limited to the roles of the project.
+func parseProjectRolesScope(projectKey, scope string) []string {
+	roles, ok := strings.CutPrefix(scope, fmt.Sprintf("%s%s:", projectRolesScopePrefix, projectKey))
+	if !ok || roles == "" {
+		return nil
+	}
+
+	return strings.Split(roles, ",")
+}
+
+func (m *ProjectOIDCIdentityMappingResourceModel) setID() {
+	m.ID = types.StringValue(strings.Join([]string{
+		m.ProjectKey.ValueString(),
+		m.ProviderName.ValueString(),
+		m.Name.ValueString(),
+	}, ":"))
+}
+
+func (m ProjectOIDCIdentityMappingResourceModel) toAPIModel(ctx context.Context) (OIDCIdentityMappingAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var claims map[string]any
+	if err := json.Unmarshal([]byte(m.ClaimsJSON.ValueString()), &claims); err != nil {
+		diags.AddAttributeError(path.Root("claims_json"), "Invalid Claims", err.Error())
+		return OIDCIdentityMappingAPIModel{}, diags
+	}
+
+	var roles []string
+	diags.Append(m.Roles.ElementsAs(ctx, &roles, false)...)
+	if diags.HasError() {
+		return OIDCIdentityMappingAPIModel{}, diags
+	}
+
+	return OIDCIdentityMappingAPIModel{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Priority:    m.Priority.ValueInt64(),
+		Claims:      claims,
+		TokenSpec: OIDCIdentityMappingTokenSpec{
+			Username:  m.Username.ValueString(),
+			Scope:     projectRolesScope(m.ProjectKey.ValueString(), roles),
+			Audience:  m.Audience.ValueString(),
+			ExpiresIn: m.ExpiresIn.ValueInt64(),
+		},
+		ProjectKey: m.ProjectKey.ValueString(),
+	}, diags
+}
+
+func (m *ProjectOIDCIdentityMappingResourceModel) fromAPIModel(ctx context.Context, mapping OIDCIdentityMappingAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = types.StringValue(mapping.Name)
+	m.Description = types.StringNull()
+	if mapping.Description != "" {
+		m.Description = types.StringValue(mapping.Description)
+	}
+	m.Priority = types.Int64Value(mapping.Priority)
+
+	// The claims are kept as configured unless they changed, so formatting and key order
+	// don't show as a diff
+	var configuredClaims map[string]any
+	if m.ClaimsJSON.IsNull() || json.Unmarshal([]byte(m.ClaimsJSON.ValueString()), &configuredClaims) != nil || !reflect.DeepEqual(configuredClaims, mapping.Claims) {
+		claims, err := json.Marshal(mapping.Claims)
+		if err != nil {
+			diags.AddError("Invalid Claims", err.Error())
+			return diags
+		}
+		m.ClaimsJSON = types.StringValue(string(claims))
+	}
+
+	// A scope not limited to the project, e.g. changed outside Terraform, shows as a diff on
+	// the roles
+	roles := parseProjectRolesScope(m.ProjectKey.ValueString(), mapping.TokenSpec.Scope)
+	rolesSet, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, roles...))
+	diags.Append(d...)
+	m.Roles = rolesSet
+
+	m.Username = types.StringNull()
+	if mapping.TokenSpec.Username != "" {
+		m.Username = types.StringValue(mapping.TokenSpec.Username)
+	}
+	m.Audience = types.StringNull()
+	if mapping.TokenSpec.Audience != "" {
+		m.Audience = types.StringValue(mapping.TokenSpec.Audience)
+	}
+	m.ExpiresIn = types.Int64Value(mapping.TokenSpec.ExpiresIn)
+
+	m.setID()
+
+	return diags
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project the tokens are scoped to.",
+			},
+			"provider_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The name of the OIDC integration, e.g. managed with the `platform_oidc_configuration` resource of the JFrog Platform provider.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The name of the identity mapping.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Description of the identity mapping.",
+			},
+			"priority": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The priority of the identity mapping. The mapping with the lowest value matching the claims of the ID token is used.",
+			},
+			"claims_json": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\s*\{`), "must be a JSON object, e.g. jsonencode({ repository = \"myorg/myrepo\" })"),
+				},
+				Description: "The claims the ID token must have for the mapping to apply, as a JSON object, e.g. `jsonencode({ repository = \"myorg/myrepo\" })`.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^,]+$`), "must not contain commas"),
+					),
+				},
+				Description: "The project roles granted by the tokens, e.g. 'Developer'. The token scope is `applied-permissions/roles:<project_key>:<roles>`, so the tokens only grant permissions in the project.",
+			},
+			"username": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The user name of the tokens. Claims of the ID token can be used, e.g. `{{sub}}`.",
+			},
+			"audience": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The audience of the tokens, e.g. `*@*` for all the JFrog services.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(60),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The lifetime of the tokens in seconds. Default to `60`.",
+			},
+		},
+		Description: "Map the ID tokens of an OIDC integration to JFrog access tokens scoped to the roles of a project, so CI pipelines get tokens limited to a single project. Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_members` is enabled.",
+	}
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+// ModifyPlan checks the roles when the provider `validate_roles` is set
+func (r *ProjectOIDCIdentityMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectOIDCIdentityMappingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ProviderData.validateRoleNames(ctx, path.Root("roles"), plan.ProjectKey.ValueString(), knownStrings(plan.Roles), nil)...)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) request(ctx context.Context, providerName, projectKey string) *resty.Request {
+	return r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("providerName", providerName).
+		SetQueryParam("project_key", projectKey)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectOIDCIdentityMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.request(ctx, plan.ProviderName.ValueString(), plan.ProjectKey.ValueString()).
+		SetBody(mapping).
+		SetError(&projectError).
+		Post(oidcIdentityMappingsUrl)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	plan.setID()
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectOIDCIdentityMappingResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mapping OIDCIdentityMappingAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := r.request(ctx, state.ProviderName.ValueString(), state.ProjectKey.ValueString()).
+		SetPathParam("name", state.Name.ValueString()).
+		SetResult(&mapping).
+		SetError(&projectError).
+		Get(oidcIdentityMappingUrl)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	// Treat HTTP 404 Not Found status as a signal to recreate resource
+	// and return early
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, mapping)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectOIDCIdentityMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, diags := plan.toAPIModel(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.request(ctx, plan.ProviderName.ValueString(), plan.ProjectKey.ValueString()).
+		SetPathParam("name", plan.Name.ValueString()).
+		SetBody(mapping).
+		SetError(&projectError).
+		Put(oidcIdentityMappingUrl)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	plan.setID()
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectOIDCIdentityMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.request(ctx, state.ProviderName.ValueString(), state.ProjectKey.ValueString()).
+		SetPathParam("name", state.Name.ValueString()).
+		SetError(&projectError).
+		Delete(oidcIdentityMappingUrl)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectOIDCIdentityMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 || lo.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected project_key:provider_name:name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
@@ -0,0 +1,109 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectOIDCIdentityMapping_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	providerName := fmt.Sprintf("tftestoidc%s", strings.ToLower(acctest.RandSeq(5)))
+	name := fmt.Sprintf("tftestmapping%s", strings.ToLower(acctest.RandSeq(5)))
+	resourceName := "project_oidc_identity_mapping." + name
+
+	template := `
+		resource "platform_oidc_configuration" "{{ .provider_name }}" {
+			name          = "{{ .provider_name }}"
+			description   = "Test OIDC integration"
+			issuer_url    = "https://token.actions.githubusercontent.com"
+			provider_type = "GitHub"
+			audience      = "jfrog-github"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_oidc_identity_mapping" "{{ .name }}" {
+			project_key   = project.{{ .project_name }}.key
+			provider_name = platform_oidc_configuration.{{ .provider_name }}.name
+			name          = "{{ .name }}"
+			priority      = {{ .priority }}
+
+			claims_json = jsonencode({
+				repository = "myorg/myrepo"
+				workflow   = "ci"
+			})
+
+			roles      = [{{ .roles }}]
+			username   = "{{ "{{" }}sub{{ "}}" }}"
+			expires_in = 300
+		}
+	`
+
+	params := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"provider_name": providerName,
+		"name":          name,
+		"priority":      1,
+		"roles":         `"Developer"`,
+	}
+	config := util.ExecuteTemplate("TestAccProjectOIDCIdentityMapping", template, params)
+
+	params["priority"] = 2
+	params["roles"] = `"Developer", "Contributor"`
+	configUpdated := util.ExecuteTemplate("TestAccProjectOIDCIdentityMapping", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"platform": {
+				Source: "jfrog/platform",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:%s:%s", projectKey, providerName, name)),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "Developer"),
+					resource.TestCheckResourceAttr(resourceName, "username", "{{sub}}"),
+					resource.TestCheckResourceAttr(resourceName, "expires_in", "300"),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "Developer"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "Contributor"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s:%s", projectKey, providerName, name),
+				ImportStateVerify: true,
+				// Formatted differently by the API
+				ImportStateVerifyIgnore: []string{"claims_json"},
+			},
+		},
+	})
+}
@@ -0,0 +1,381 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectRepositoriesResource() resource.Resource {
+	return &ProjectRepositoriesResource{
+		TypeName: "project_repositories",
+	}
+}
+
+type ProjectRepositoriesResource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectRepositoriesResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ProjectKey       types.String `tfsdk:"project_key"`
+	RepoKeys         types.Set    `tfsdk:"repo_keys"`
+	DryRun           types.Bool   `tfsdk:"dry_run"`
+	PendingAdditions types.Set    `tfsdk:"pending_additions"`
+	PendingRemovals  types.Set    `tfsdk:"pending_removals"`
+}
+
+func (r *ProjectRepositoriesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectRepositoriesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project to which the repositories should be assigned to.",
+			},
+			"repo_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validatorfw_string.RepoKey()),
+				},
+				Description: "The keys of the repositories assigned to the project. Repositories assigned to the project but not listed here are unassigned.",
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, planning the resource only reports the repositories it would assign and unassign, in `pending_additions`, `pending_removals` and a warning, and applying it doesn't change the project. Destroying it leaves the repositories assigned. Set it back to `false` to apply the changes, e.g. after reviewing them in a staged rollout. Default to `false`.",
+			},
+			"pending_additions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the repositories that would be assigned to the project while `dry_run` is set. Empty once they are applied.",
+			},
+			"pending_removals": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the repositories that would be unassigned from the project while `dry_run` is set. Empty once they are applied.",
+			},
+		},
+		Description: "Assign a set of repositories to a project. Only the repositories added to or removed from the set are assigned or unassigned, so large numbers of repositories are managed with few API calls and a small state. The set is authoritative: don't combine it with `project_repository` resources or the `repos` attribute of `project` for the same project. Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
+	}
+}
+
+func (r *ProjectRepositoriesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+func (m *ProjectRepositoriesResourceModel) setRepoKeys(ctx context.Context, repoKeys []string) diag.Diagnostics {
+	// readRepos returns nil for a project without repositories, which would be a null set
+	repoKeysSet, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, repoKeys...))
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ID = m.ProjectKey
+	m.RepoKeys = repoKeysSet
+
+	return diags
+}
+
+func (m *ProjectRepositoriesResourceModel) setPendingRepoKeys(ctx context.Context, additions, removals []string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	pendingAdditions, ds := types.SetValueFrom(ctx, types.StringType, append([]string{}, additions...))
+	diags.Append(ds...)
+
+	pendingRemovals, ds := types.SetValueFrom(ctx, types.StringType, append([]string{}, removals...))
+	diags.Append(ds...)
+
+	m.PendingAdditions = pendingAdditions
+	m.PendingRemovals = pendingRemovals
+
+	return diags
+}
+
+// dryRun sets the repositories the apply would assign and unassign as pending and reports
+// them in a warning, without changing the project
+func (r *ProjectRepositoriesResource) dryRun(ctx context.Context, plan *ProjectRepositoriesResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var repoKeys []string
+	diags.Append(plan.RepoKeys.ElementsAs(ctx, &repoKeys, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+	projectRepoKeys, err := readRepos(ctx, projectKey, r.ProviderData.Client)
+	if err != nil {
+		diags.AddError("Unable to read project repositories", err.Error())
+		return diags
+	}
+
+	additions, removals := lo.Difference(repoKeys, projectRepoKeys)
+	diags.Append(plan.setPendingRepoKeys(ctx, additions, removals)...)
+	if diags.HasError() || (len(additions) == 0 && len(removals) == 0) {
+		return diags
+	}
+
+	formatRepoKeys := func(repoKeys []string) string {
+		if len(repoKeys) == 0 {
+			return "none"
+		}
+		return strings.Join(repoKeys, ", ")
+	}
+
+	diags.AddWarning(
+		"Dry run, project repositories not changed",
+		fmt.Sprintf("`dry_run` is set, so project '%s' is left unchanged. Repositories that would be assigned: %s. Repositories that would be unassigned: %s.", projectKey, formatRepoKeys(additions), formatRepoKeys(removals)),
+	)
+
+	return diags
+}
+
+// ModifyPlan computes `pending_additions` and `pending_removals` when `dry_run` is set, so
+// the repositories that would be assigned and unassigned are reviewed in the plan. They are
+// left unknown, and computed on apply, when the project or repositories aren't known yet.
+func (r *ProjectRepositoriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectRepositoriesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DryRun.IsUnknown() {
+		return
+	}
+
+	if !plan.DryRun.ValueBool() {
+		resp.Diagnostics.Append(plan.setPendingRepoKeys(ctx, nil, nil)...)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	repoKeysUnknown := lo.SomeBy(plan.RepoKeys.Elements(), func(repoKey attr.Value) bool {
+		return repoKey.IsUnknown()
+	})
+	if plan.ProjectKey.IsUnknown() || plan.RepoKeys.IsUnknown() || repoKeysUnknown {
+		return
+	}
+
+	resp.Diagnostics.Append(r.dryRun(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ProjectRepositoriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectRepositoriesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DryRun.ValueBool() {
+		// The pending repositories are computed on plan, unless they weren't known yet
+		if plan.PendingAdditions.IsUnknown() || plan.PendingRemovals.IsUnknown() {
+			resp.Diagnostics.Append(r.dryRun(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		plan.ID = plan.ProjectKey
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	var repoKeys []string
+	resp.Diagnostics.Append(plan.RepoKeys.ElementsAs(ctx, &repoKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the difference with the repositories of the project is sent
+	projectRepoKeys, err := updateRepos(ctx, plan.ProjectKey.ValueString(), repoKeys, true, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setRepoKeys(ctx, projectRepoKeys)...)
+	resp.Diagnostics.Append(plan.setPendingRepoKeys(ctx, nil, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectRepositoriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectRepositoriesResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// With dry_run the repositories aren't assigned, so repo_keys is kept as configured and
+	// the difference with the project is planned in pending_additions and pending_removals
+	if !state.DryRun.ValueBool() {
+		repoKeys, err := readRepos(ctx, state.ProjectKey.ValueString(), r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToRefreshResourceError(resp, err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(state.setRepoKeys(ctx, repoKeys)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Not set after import or an upgrade from a version without dry_run
+	if state.DryRun.IsNull() {
+		state.DryRun = types.BoolValue(false)
+	}
+	if state.PendingAdditions.IsNull() || state.PendingRemovals.IsNull() {
+		resp.Diagnostics.Append(state.setPendingRepoKeys(ctx, nil, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectRepositoriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectRepositoriesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DryRun.ValueBool() {
+		// The pending repositories are computed on plan, unless they weren't known yet
+		if plan.PendingAdditions.IsUnknown() || plan.PendingRemovals.IsUnknown() {
+			resp.Diagnostics.Append(r.dryRun(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		plan.ID = plan.ProjectKey
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	var repoKeys []string
+	resp.Diagnostics.Append(plan.RepoKeys.ElementsAs(ctx, &repoKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the difference with the repositories of the project is sent
+	projectRepoKeys, err := updateRepos(ctx, plan.ProjectKey.ValueString(), repoKeys, true, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setRepoKeys(ctx, projectRepoKeys)...)
+	resp.Diagnostics.Append(plan.setPendingRepoKeys(ctx, nil, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectRepositoriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectRepositoriesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var repoKeys []string
+	resp.Diagnostics.Append(state.RepoKeys.ElementsAs(ctx, &repoKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DryRun.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Dry run, project repositories not changed",
+			fmt.Sprintf("`dry_run` is set, so the repositories of project '%s' are left assigned.", state.ProjectKey.ValueString()),
+		)
+		return
+	}
+
+	if err := deleteRepos(ctx, repoKeys, r.ProviderData.Client); err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectRepositoriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
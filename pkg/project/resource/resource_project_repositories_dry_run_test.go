@@ -0,0 +1,105 @@
+package project
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// projectRepositoriesValue returns a project_repositories object with the given repo_keys and dry_run
+func projectRepositoriesValue(t *testing.T, objectType tftypes.Object, repoKeys []string, dryRun bool) tftypes.Value {
+	t.Helper()
+
+	setType := tftypes.Set{ElementType: tftypes.String}
+	repoKeyValues := make([]tftypes.Value, 0, len(repoKeys))
+	for _, repoKey := range repoKeys {
+		repoKeyValues = append(repoKeyValues, tftypes.NewValue(tftypes.String, repoKey))
+	}
+
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"project_key":       tftypes.NewValue(tftypes.String, "myproj"),
+		"repo_keys":         tftypes.NewValue(setType, repoKeyValues),
+		"dry_run":           tftypes.NewValue(tftypes.Bool, dryRun),
+		"pending_additions": tftypes.NewValue(setType, tftypes.UnknownValue),
+		"pending_removals":  tftypes.NewValue(setType, tftypes.UnknownValue),
+	})
+}
+
+func TestProjectRepositoriesModifyPlan_dryRun(t *testing.T) {
+	ctx := context.Background()
+
+	r := &ProjectRepositoriesResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	defer func(original func(context.Context, string, *resty.Client) ([]string, error)) {
+		readRepos = original
+	}(readRepos)
+
+	readRepos = func(context.Context, string, *resty.Client) ([]string, error) {
+		return []string{"repo-a", "repo-b"}, nil
+	}
+
+	testCases := []struct {
+		name              string
+		repoKeys          []string
+		dryRun            bool
+		expectedAdditions []string
+		expectedRemovals  []string
+		expectedWarnings  int
+	}{
+		{name: "dry run with changes", repoKeys: []string{"repo-b", "repo-c"}, dryRun: true, expectedAdditions: []string{"repo-c"}, expectedRemovals: []string{"repo-a"}, expectedWarnings: 1},
+		{name: "dry run without changes", repoKeys: []string{"repo-a", "repo-b"}, dryRun: true, expectedAdditions: []string{}, expectedRemovals: []string{}},
+		{name: "no dry run", repoKeys: []string{"repo-c"}, dryRun: false, expectedAdditions: []string{}, expectedRemovals: []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: projectRepositoriesValue(t, objectType, tc.repoKeys, tc.dryRun)},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var plan ProjectRepositoriesResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var additions, removals []string
+			resp.Diagnostics.Append(plan.PendingAdditions.ElementsAs(ctx, &additions, false)...)
+			resp.Diagnostics.Append(plan.PendingRemovals.ElementsAs(ctx, &removals, false)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			sort.Strings(additions)
+			sort.Strings(removals)
+
+			if !slices.Equal(additions, tc.expectedAdditions) {
+				t.Errorf("expected pending_additions %v, got %v", tc.expectedAdditions, additions)
+			}
+			if !slices.Equal(removals, tc.expectedRemovals) {
+				t.Errorf("expected pending_removals %v, got %v", tc.expectedRemovals, removals)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tc.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.expectedWarnings, got, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectRepositories_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	resourceName := fmt.Sprintf("project_repositories.%s", projectKey)
+
+	repoKey1 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey2 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey3 := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	template := `
+		{{ range .all_repo_keys }}
+		resource "artifactory_local_generic_repository" "{{ . }}" {
+			key = "{{ . }}"
+
+			lifecycle {
+				ignore_changes = ["project_key"]
+			}
+		}
+		{{ end }}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_repositories" "{{ .project_key }}" {
+			project_key = project.{{ .project_name }}.key
+			repo_keys   = [
+				{{ range .repo_keys }}
+				artifactory_local_generic_repository.{{ . }}.key,
+				{{ end }}
+			]
+		}
+	`
+
+	allRepoKeys := []string{repoKey1, repoKey2, repoKey3}
+
+	config := util.ExecuteTemplate("TestAccProjectRepositories", template, map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"all_repo_keys": allRepoKeys,
+		"repo_keys":     []string{repoKey1, repoKey2},
+	})
+
+	configUpdated := util.ExecuteTemplate("TestAccProjectRepositories", template, map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"all_repo_keys": allRepoKeys,
+		"repo_keys":     []string{repoKey2, repoKey3},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "repo_keys.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repo_keys.*", repoKey1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repo_keys.*", repoKey2),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "repo_keys.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repo_keys.*", repoKey2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repo_keys.*", repoKey3),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        projectKey,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_key",
+			},
+		},
+	})
+}
+
+func TestAccProjectRepositories_dryRun(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	resourceName := fmt.Sprintf("project_repositories.%s", projectKey)
+
+	repoKey1 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey2 := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	template := `
+		{{ range .repo_keys }}
+		resource "artifactory_local_generic_repository" "{{ . }}" {
+			key = "{{ . }}"
+
+			lifecycle {
+				ignore_changes = ["project_key"]
+			}
+		}
+		{{ end }}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_repositories" "{{ .project_key }}" {
+			project_key = project.{{ .project_name }}.key
+			repo_keys   = [
+				{{ range .repo_keys }}
+				artifactory_local_generic_repository.{{ . }}.key,
+				{{ end }}
+			]
+			dry_run     = {{ .dry_run }}
+		}
+	`
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"repo_keys":    []string{repoKey1, repoKey2},
+		"dry_run":      true,
+	}
+	configDryRun := util.ExecuteTemplate("TestAccProjectRepositories", template, params)
+
+	params["dry_run"] = false
+	config := util.ExecuteTemplate("TestAccProjectRepositories", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configDryRun,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dry_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "pending_additions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "pending_additions.*", repoKey1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "pending_additions.*", repoKey2),
+					resource.TestCheckResourceAttr(resourceName, "pending_removals.#", "0"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dry_run", "false"),
+					resource.TestCheckResourceAttr(resourceName, "repo_keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pending_additions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pending_removals.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -8,10 +8,14 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +27,11 @@ import (
 
 const repositoryEndpoint = "/artifactory/api/repositories/{key}"
 
+const (
+	onDestroyUnassign = "unassign"
+	onDestroyLeave    = "leave"
+)
+
 func NewProjectRepositoryResource() resource.Resource {
 	return &ProjectRepositoryResource{
 		TypeName: "project_repository",
@@ -30,19 +39,39 @@ func NewProjectRepositoryResource() resource.Resource {
 }
 
 type ProjectRepositoryResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
 type ProjectRepositoryResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Key        types.String `tfsdk:"key"`
-	ProjectKey types.String `tfsdk:"project_key"`
+	ID           types.String `tfsdk:"id"`
+	Key          types.String `tfsdk:"key"`
+	ProjectKey   types.String `tfsdk:"project_key"`
+	RepoType     types.String `tfsdk:"repo_type"`
+	PackageType  types.String `tfsdk:"package_type"`
+	Environments types.Set    `tfsdk:"environments"`
+	OnDestroy    types.String `tfsdk:"on_destroy"`
+}
+
+func (m *ProjectRepositoryResourceModel) fromAPIModel(ctx context.Context, repo ProjectRepositoryAPIModel) diag.Diagnostics {
+	environments, diags := types.SetValueFrom(ctx, types.StringType, repo.Environments)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.RepoType = types.StringValue(repo.Rclass)
+	m.PackageType = types.StringValue(repo.PackageType)
+	m.Environments = environments
+
+	return diags
 }
 
 type ProjectRepositoryAPIModel struct {
-	Key        string `json:"key"`
-	ProjectKey string `json:"projectKey"`
+	Key          string   `json:"key"`
+	ProjectKey   string   `json:"projectKey"`
+	Rclass       string   `json:"rclass"`
+	PackageType  string   `json:"packageType"`
+	Environments []string `json:"environments"`
 }
 
 func (r *ProjectRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,6 +105,37 @@ func (r *ProjectRepositoryResource) Schema(ctx context.Context, req resource.Sch
 				},
 				Description: "The key of the project to which the repository should be assigned to.",
 			},
+			"repo_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The class of the repository, e.g. `local`, `remote`, `virtual` or `federated`.",
+			},
+			"package_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The package type of the repository, e.g. `generic` or `docker`.",
+			},
+			"environments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The environments of the project the repository is assigned to, e.g. `DEV` or `PROD`.",
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(onDestroyUnassign),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyUnassign, onDestroyLeave),
+				},
+				Description: fmt.Sprintf("What happens to the assignment when the resource is destroyed. `%s` removes the repository from the project. `%s` only removes the resource from the Terraform state, e.g. when another system takes over managing the assignment. Default to `%s`.", onDestroyUnassign, onDestroyLeave, onDestroyUnassign),
+			},
 		},
 		Description: "Assign a repository to a project. Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
 	}
@@ -86,7 +146,7 @@ func (r *ProjectRepositoryResource) Configure(ctx context.Context, req resource.
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
 }
 
 func (r *ProjectRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -116,14 +176,15 @@ func (r *ProjectRepositoryResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
+	var repo ProjectRepositoryAPIModel
 	var retryFunc = func() error {
-		var repo ProjectRepositoryAPIModel
 		resp, err := r.ProviderData.Client.R().
 			SetResult(&repo).
+			SetError(&projectError).
 			SetPathParam("key", repoKey).
 			Get(repositoryEndpoint)
 
@@ -131,7 +192,7 @@ func (r *ProjectRepositoryResource) Create(ctx context.Context, req resource.Cre
 			return fmt.Errorf("error getting repository: %s", err)
 		}
 		if resp.IsError() {
-			return fmt.Errorf("error getting repository: %s", resp.String())
+			return fmt.Errorf("error getting repository: %s", apiErrorMessage(resp, projectError))
 		}
 
 		if repo.ProjectKey == "" {
@@ -152,6 +213,10 @@ func (r *ProjectRepositoryResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("%s-%s", projectKey, repoKey))
+	resp.Diagnostics.Append(plan.fromAPIModel(ctx, repo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -179,12 +244,14 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	var projectError ProjectErrorsResponse
+	var repo ProjectRepositoryAPIModel
 	if newAPIVersion {
 		// use new project API
 		var status ProjectRepositoryStatusAPIModel
 		response, err := r.ProviderData.Client.R().
 			SetResult(&status).
 			SetPathParam("repo_key", repoKey).
+			SetError(&projectError).
 			Get(ProjectRepositoryStatusEndpoint)
 		if err != nil {
 			utilfw.UnableToRefreshResourceError(resp, err.Error())
@@ -197,7 +264,7 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 		}
 
 		if response.IsError() {
-			utilfw.UnableToRefreshResourceError(resp, projectError.String())
+			utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 			return
 		}
 
@@ -209,12 +276,27 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
+
+		// project status API doesn't include the repository details
+		response, err = r.ProviderData.Client.R().
+			SetResult(&repo).
+			SetPathParam("key", repoKey).
+			SetError(&projectError).
+			Get(repositoryEndpoint)
+		if err != nil {
+			utilfw.UnableToRefreshResourceError(resp, err.Error())
+			return
+		}
+		if response.IsError() {
+			utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
+			return
+		}
 	} else {
 		// continue using old repo API for checking
-		var repo ProjectRepositoryAPIModel
 		response, err := r.ProviderData.Client.R().
 			SetResult(&repo).
 			SetPathParam("key", repoKey).
+			SetError(&projectError).
 			Get(repositoryEndpoint)
 		if err != nil {
 			utilfw.UnableToRefreshResourceError(resp, err.Error())
@@ -226,7 +308,7 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 			return
 		}
 		if response.IsError() {
-			utilfw.UnableToRefreshResourceError(resp, projectError.String())
+			utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 			return
 		}
 		if repo.ProjectKey == "" {
@@ -241,16 +323,31 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 
 	state.ID = types.StringValue(fmt.Sprintf("%s-%s", projectKey, repoKey))
 	state.ProjectKey = types.StringValue(projectKey)
+	// on_destroy isn't stored by the platform, e.g. it is null after import
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(onDestroyUnassign)
+	}
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, repo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// Update only stores on_destroy as changing any other attribute replaces the assignment.
 func (r *ProjectRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning(
-		"Update not supported",
-		"Repository assignment to project cannnot be updated.",
-	)
+	var plan ProjectRepositoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ProjectRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -264,6 +361,14 @@ func (r *ProjectRepositoryResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	if state.OnDestroy.ValueString() == onDestroyLeave {
+		tflog.Info(ctx, "leaving repository assigned to project", map[string]any{
+			"repoKey":    state.Key.ValueString(),
+			"projectKey": state.ProjectKey.ValueString(),
+		})
+		return
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
 		SetPathParam("repoKey", state.Key.ValueString()).
@@ -274,7 +379,7 @@ func (r *ProjectRepositoryResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 	if response.IsError() && response.StatusCode() != http.StatusNotFound {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
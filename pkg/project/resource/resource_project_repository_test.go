@@ -185,6 +185,10 @@ func TestAccProjectRepository_full(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName1, "project_key", params["project_key"].(string)),
 					resource.TestCheckResourceAttr(resourceName1, "key", params["repo_key"].(string)),
+					resource.TestCheckResourceAttr(resourceName1, "repo_type", "local"),
+					resource.TestCheckResourceAttr(resourceName1, "package_type", "generic"),
+					resource.TestCheckResourceAttrSet(resourceName1, "environments.#"),
+					resource.TestCheckResourceAttr(resourceName1, "on_destroy", "unassign"),
 				),
 			},
 			{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -17,6 +18,7 @@ import (
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
 )
 
 const ProjectRolesUrl = ProjectUrl + "/roles"
@@ -64,6 +66,27 @@ var validRoleActions = []string{
 	"MANAGE_RESOURCES",
 }
 
+// Actions the platform adds to a role on its own when the action they are keyed by is granted
+var impliedRoleActions = map[string][]string{
+	"ANNOTATE_REPOSITORY":           {"READ_REPOSITORY"},
+	"DEPLOY_CACHE_REPOSITORY":       {"READ_REPOSITORY", "ANNOTATE_REPOSITORY"},
+	"DELETE_OVERWRITE_REPOSITORY":   {"READ_REPOSITORY", "ANNOTATE_REPOSITORY", "DEPLOY_CACHE_REPOSITORY"},
+	"MANAGE_XRAY_MD_REPOSITORY":     {"READ_REPOSITORY"},
+	"ANNOTATE_RELEASE_BUNDLE":       {"READ_RELEASE_BUNDLE"},
+	"CREATE_RELEASE_BUNDLE":         {"READ_RELEASE_BUNDLE", "ANNOTATE_RELEASE_BUNDLE"},
+	"DISTRIBUTE_RELEASE_BUNDLE":     {"READ_RELEASE_BUNDLE"},
+	"DELETE_RELEASE_BUNDLE":         {"READ_RELEASE_BUNDLE", "ANNOTATE_RELEASE_BUNDLE"},
+	"MANAGE_XRAY_MD_RELEASE_BUNDLE": {"READ_RELEASE_BUNDLE"},
+	"ANNOTATE_BUILD":                {"READ_BUILD"},
+	"DEPLOY_BUILD":                  {"READ_BUILD", "ANNOTATE_BUILD"},
+	"DELETE_BUILD":                  {"READ_BUILD", "ANNOTATE_BUILD", "DEPLOY_BUILD"},
+	"MANAGE_XRAY_MD_BUILD":          {"READ_BUILD"},
+	"TRIGGER_PIPELINE":              {"READ_SOURCES_PIPELINE"},
+	"MANAGE_SOURCES_PIPELINE":       {"READ_SOURCES_PIPELINE"},
+	"MANAGE_INTEGRATIONS_PIPELINE":  {"READ_INTEGRATIONS_PIPELINE"},
+	"MANAGE_POOLS_PIPELINE":         {"READ_POOLS_PIPELINE"},
+}
+
 func NewProjectRoleResource() resource.Resource {
 	return &ProjectRoleResource{
 		TypeName: "project_role",
@@ -71,7 +94,7 @@ func NewProjectRoleResource() resource.Resource {
 }
 
 type ProjectRoleResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
@@ -136,7 +159,7 @@ func (r *ProjectRoleResource) Schema(ctx context.Context, req resource.SchemaReq
 			"actions": schema.SetAttribute{
 				ElementType: types.StringType,
 				Required:    true,
-				Description: fmt.Sprintf("List of pre-defined actions (%s)", strings.Join(validRoleActions, ", ")),
+				Description: fmt.Sprintf("List of pre-defined actions (%s). Actions the platform adds because they are implied by a configured action, e.g. `READ_REPOSITORY` for `DEPLOY_CACHE_REPOSITORY`, are not reported as drift. The `MANAGE_XRAY_MD_*` actions require Artifactory 7.63.2 or later.", strings.Join(validRoleActions, ", ")),
 			},
 		},
 		Description: "Create a project role. Element has one to one mapping with the [JFrog Project Roles API](https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API#ArtifactoryRESTAPI-AddaNewRole). Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
@@ -148,7 +171,7 @@ func (r *ProjectRoleResource) Configure(ctx context.Context, req resource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
 }
 
 func (r *ProjectRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -191,9 +214,11 @@ func (r *ProjectRoleResource) Create(ctx context.Context, req resource.CreateReq
 		Post(ProjectRolesUrl)
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
 	}
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	plan.ID = types.StringValue(role.Name)
@@ -234,7 +259,7 @@ func (r *ProjectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -250,7 +275,18 @@ func (r *ProjectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	state.Environments = environments
 
-	actions, ds := types.SetValueFrom(ctx, types.StringType, role.Actions)
+	roleActions := role.Actions
+	if !state.Actions.IsNull() && !state.Actions.IsUnknown() {
+		var stateActions []string
+		resp.Diagnostics.Append(state.Actions.ElementsAs(ctx, &stateActions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		roleActions = normalizeRoleActions(stateActions, role.Actions)
+	}
+
+	actions, ds := types.SetValueFrom(ctx, types.StringType, roleActions)
 	if ds.HasError() {
 		resp.Diagnostics.Append(ds...)
 		return
@@ -261,6 +297,69 @@ func (r *ProjectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// ModifyPlan rejects actions the platform version doesn't support yet.
+func (r *ProjectRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Actions.IsNull() || plan.Actions.IsUnknown() {
+		return
+	}
+
+	var actions []string
+	resp.Diagnostics.Append(plan.Actions.ElementsAs(ctx, &actions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slices.Sort(actions)
+	for _, action := range actions {
+		minVersion, ok := roleActionMinVersions[strings.ToUpper(action)]
+		if !ok {
+			continue
+		}
+
+		resp.Diagnostics.Append(r.ProviderData.requireVersion(path.Root("actions"), fmt.Sprintf("Role action `%s`", action), minVersion)...)
+	}
+}
+
+// normalizeRoleActions keeps the spelling of actions already in state and drops actions
+// the platform added because they are implied by another action of the role, so neither
+// shows up as drift. Other actions returned by the API are kept as is.
+func normalizeRoleActions(stateActions, apiActions []string) []string {
+	stateActionsByName := lo.SliceToMap(stateActions, func(action string) (string, string) {
+		return strings.ToUpper(strings.TrimSpace(action)), action
+	})
+	apiActionNames := lo.Map(apiActions, func(action string, _ int) string {
+		return strings.ToUpper(strings.TrimSpace(action))
+	})
+
+	var actions []string
+	for _, name := range lo.Uniq(apiActionNames) {
+		if action, ok := stateActionsByName[name]; ok {
+			actions = append(actions, action)
+			continue
+		}
+
+		implied := lo.ContainsBy(apiActionNames, func(other string) bool {
+			return lo.Contains(impliedRoleActions[other], name)
+		})
+		if !implied {
+			actions = append(actions, name)
+		}
+	}
+
+	return actions
+}
+
 func (r *ProjectRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -304,9 +403,11 @@ func (r *ProjectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 		Put(ProjectRoleUrl)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
 	}
 	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		utilfw.UnableToUpdateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	plan.ID = types.StringValue(role.Name)
@@ -341,7 +442,7 @@ func (r *ProjectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 	if response.IsError() && response.StatusCode() != http.StatusNotFound {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
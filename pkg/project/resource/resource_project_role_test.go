@@ -232,6 +232,64 @@ func TestAccProjectRole_conflict_with_project(t *testing.T) {
 	})
 }
 
+func TestAccProjectRole_implied_actions(t *testing.T) {
+	name := acctest.RandSeq(20)
+	resourceName := fmt.Sprintf("project_role.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	template := `
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_role" "{{ .name }}" {
+			name = "{{ .name }}"
+			type = "CUSTOM"
+			project_key = project.{{ .project_name }}.key
+
+			environments = ["DEV"]
+			actions = ["DEPLOY_CACHE_REPOSITORY", "DELETE_BUILD"]
+		}
+	`
+
+	testData := map[string]string{
+		"name":         name,
+		"project_name": projectKey,
+		"project_key":  projectKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectRole", template, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(resourceName, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyRole(id, projectKey, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "actions.*", "DEPLOY_CACHE_REPOSITORY"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "actions.*", "DELETE_BUILD"),
+				),
+			},
+			{
+				Config:           config,
+				PlanOnly:         true,
+				ConfigPlanChecks: testutil.ConfigPlanChecks(resourceName),
+			},
+		},
+	})
+}
+
 func verifyRole(name, projectKey string, request *resty.Request) (*resty.Response, error) {
 	return request.
 		SetPathParams(map[string]string{
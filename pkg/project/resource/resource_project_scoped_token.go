@@ -0,0 +1,473 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+const (
+	accessTokensUrl = "/access/api/v1/tokens"
+	accessTokenUrl  = accessTokensUrl + "/{id}"
+)
+
+func NewProjectScopedTokenResource() resource.Resource {
+	return &ProjectScopedTokenResource{
+		TypeName: "project_scoped_token",
+	}
+}
+
+type ProjectScopedTokenResource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectScopedTokenResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectKey    types.String `tfsdk:"project_key"`
+	Roles         types.Set    `tfsdk:"roles"`
+	Username      types.String `tfsdk:"username"`
+	Description   types.String `tfsdk:"description"`
+	Audience      types.String `tfsdk:"audience"`
+	ExpiresIn     types.Int64  `tfsdk:"expires_in"`
+	Refreshable   types.Bool   `tfsdk:"refreshable"`
+	RefreshWindow types.String `tfsdk:"refresh_window"`
+	Scope         types.String `tfsdk:"scope"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	RefreshToken  types.String `tfsdk:"refresh_token"`
+}
+
+type AccessTokenRequestAPIModel struct {
+	GrantType    string `json:"grant_type"`
+	Username     string `json:"username,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	ExpiresIn    *int64 `json:"expires_in,omitempty"`
+	Refreshable  bool   `json:"refreshable,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Audience     string `json:"audience,omitempty"`
+	ProjectKey   string `json:"project_key,omitempty"`
+	AccessToken  string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+type AccessTokenAPIModel struct {
+	TokenId      string `json:"token_id"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+type AccessTokenInfoAPIModel struct {
+	TokenId string `json:"token_id"`
+	Subject string `json:"subject"`
+	Expiry  int64  `json:"expiry"`
+}
+
+// isInRefreshWindow reports whether the token expires within `refresh_window`
+func (m ProjectScopedTokenResourceModel) isInRefreshWindow() bool {
+	if m.RefreshWindow.IsNull() || m.RefreshWindow.IsUnknown() || m.ExpiresAt.IsNull() || m.ExpiresAt.IsUnknown() {
+		return false
+	}
+
+	window, err := time.ParseDuration(m.RefreshWindow.ValueString())
+	if err != nil {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return time.Until(expiresAt) < window
+}
+
+func (m *ProjectScopedTokenResourceModel) fromAPIModel(token AccessTokenAPIModel) {
+	m.ID = types.StringValue(token.TokenId)
+	m.AccessToken = types.StringValue(token.AccessToken)
+	m.RefreshToken = types.StringNull()
+	if token.RefreshToken != "" {
+		m.RefreshToken = types.StringValue(token.RefreshToken)
+	}
+	if m.ExpiresIn.IsUnknown() {
+		m.ExpiresIn = types.Int64Value(token.ExpiresIn)
+	}
+	// A token without expiry has no expires_in
+	m.ExpiresAt = types.StringNull()
+	if token.ExpiresIn > 0 {
+		m.ExpiresAt = types.StringValue(time.Now().Add(time.Duration(token.ExpiresIn) * time.Second).UTC().Format(time.RFC3339))
+	}
+}
+
+func (r *ProjectScopedTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectScopedTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The ID of the token.",
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project the token is scoped to.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^,]+$`), "must not contain commas"),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Description: "The project roles granted by the token, e.g. 'Developer'. The token scope is `applied-permissions/roles:<project_key>:<roles>`, so the token only grants permissions in the project.",
+			},
+			"username": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The user name of the token, which doesn't need to be an existing user. Default to the user of the provider.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Description of the token.",
+			},
+			"audience": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The audience of the token, e.g. `jfrt@*` for all the Artifactory instances. Default to all the JFrog services.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "The lifetime of the token in seconds. Set to `0` for a token that doesn't expire, if allowed by the platform. Default to the default expiry of the platform.",
+			},
+			"refreshable": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "When set to `true`, a refresh token is issued with the token, and the token is refreshed instead of replaced once it enters `refresh_window`. Default to `false`.",
+			},
+			"refresh_window": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					IsDuration(),
+				},
+				Description: "Duration before the expiry of the token, e.g. `168h`, from which the next apply issues a new token: the token is refreshed when `refreshable` is `true`, replaced otherwise. The token is never renewed when not set.",
+			},
+			"scope": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The scope of the token.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "RFC 3339 timestamp of the expiry of the token. Not set for a token that doesn't expire.",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The access token.",
+			},
+			"refresh_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The refresh token. Only set when `refreshable` is `true`.",
+			},
+		},
+		Description: "Create an access token scoped to the roles of a project, with the scope `applied-permissions/roles:<project_key>:<roles>`. The token is stored in the Terraform state, which must be secured accordingly. The token is revoked on destroy. Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_members` is enabled.",
+	}
+}
+
+func (r *ProjectScopedTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+// ModifyPlan checks the roles when the provider `validate_roles` is set, and plans a new
+// token once the token enters `refresh_window`.
+func (r *ProjectScopedTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectScopedTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ProjectKey.IsUnknown() {
+		resp.Diagnostics.Append(r.ProviderData.validateRoleNames(ctx, path.Root("roles"), plan.ProjectKey.ValueString(), knownStrings(plan.Roles), nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() || !plan.isInRefreshWindow() {
+		return
+	}
+
+	plan.ID = types.StringUnknown()
+	plan.ExpiresAt = types.StringUnknown()
+	plan.AccessToken = types.StringUnknown()
+	plan.RefreshToken = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	// Only a refreshable token can be renewed in place
+	if !plan.Refreshable.ValueBool() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("access_token"))
+	}
+}
+
+func (r *ProjectScopedTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectScopedTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roles []string
+	resp.Diagnostics.Append(plan.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenRequest := AccessTokenRequestAPIModel{
+		GrantType:   "client_credentials",
+		Username:    plan.Username.ValueString(),
+		Scope:       projectRolesScope(plan.ProjectKey.ValueString(), roles),
+		Refreshable: plan.Refreshable.ValueBool(),
+		Description: plan.Description.ValueString(),
+		Audience:    plan.Audience.ValueString(),
+		ProjectKey:  plan.ProjectKey.ValueString(),
+	}
+	if !plan.ExpiresIn.IsUnknown() && !plan.ExpiresIn.IsNull() {
+		tokenRequest.ExpiresIn = plan.ExpiresIn.ValueInt64Pointer()
+	}
+
+	token, diags := r.requestToken(ctx, tokenRequest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Scope = types.StringValue(tokenRequest.Scope)
+	plan.fromAPIModel(token)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectScopedTokenResource) requestToken(ctx context.Context, tokenRequest AccessTokenRequestAPIModel) (AccessTokenAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var token AccessTokenAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetBody(tokenRequest).
+		SetResult(&token).
+		SetError(&projectError).
+		Post(accessTokensUrl)
+	if err != nil {
+		diags.AddError("Unable to Create Token", err.Error())
+		return token, diags
+	}
+	if response.IsError() {
+		diags.AddError("Unable to Create Token", apiErrorMessage(response, projectError))
+		return token, diags
+	}
+
+	return token, diags
+}
+
+func (r *ProjectScopedTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectScopedTokenResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tokenInfo AccessTokenInfoAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("id", state.ID.ValueString()).
+		SetResult(&tokenInfo).
+		SetError(&projectError).
+		Get(accessTokenUrl)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	// The token was revoked or has expired, so a new one is created
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	if tokenInfo.Expiry > 0 {
+		state.ExpiresAt = types.StringValue(time.Unix(tokenInfo.Expiry, 0).UTC().Format(time.RFC3339))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update refreshes the token once it enters `refresh_window`, any other change only
+// updates `refresh_window`.
+func (r *ProjectScopedTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectScopedTokenResourceModel
+	var state ProjectScopedTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AccessToken.IsUnknown() {
+		// The refresh revokes the previous token
+		token, diags := r.requestToken(ctx, AccessTokenRequestAPIModel{
+			GrantType:    "refresh_token",
+			AccessToken:  state.AccessToken.ValueString(),
+			RefreshToken: state.RefreshToken.ValueString(),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.fromAPIModel(token)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectScopedTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectScopedTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("id", state.ID.ValueString()).
+		SetError(&projectError).
+		Delete(accessTokenUrl)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+	// Already revoked or expired
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		utilfw.UnableToDeleteResourceError(resp, fmt.Sprintf("failed to revoke token %s: %s", state.ID.ValueString(), apiErrorMessage(response, projectError)))
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
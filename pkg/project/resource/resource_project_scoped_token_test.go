@@ -0,0 +1,89 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectScopedToken_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	name := fmt.Sprintf("tftesttoken%s", strings.ToLower(acctest.RandSeq(5)))
+	resourceName := "project_scoped_token." + name
+
+	template := `
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_scoped_token" "{{ .name }}" {
+			project_key    = project.{{ .project_name }}.key
+			roles          = ["Developer"]
+			username       = "{{ .name }}"
+			description    = "Test token"
+			expires_in     = 3600
+			refreshable    = true
+			refresh_window = "{{ .refresh_window }}"
+		}
+	`
+
+	params := map[string]interface{}{
+		"project_name":   projectName,
+		"project_key":    projectKey,
+		"name":           name,
+		"refresh_window": "10m",
+	}
+	config := util.ExecuteTemplate("TestAccProjectScopedToken", template, params)
+
+	// The token expires within the window, so it is refreshed
+	params["refresh_window"] = "2h"
+	configRefreshed := util.ExecuteTemplate("TestAccProjectScopedToken", template, params)
+
+	var tokenID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scope", fmt.Sprintf("applied-permissions/roles:%s:Developer", projectKey)),
+					resource.TestCheckResourceAttr(resourceName, "expires_in", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "refresh_token"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						tokenID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: configRefreshed,
+				// The window is longer than the lifetime of the token, so every apply refreshes it
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "access_token"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						if value == tokenID {
+							return fmt.Errorf("expected token %s to be refreshed", tokenID)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -30,7 +30,7 @@ func NewProjectShareRepositoryResource() resource.Resource {
 }
 
 type ProjectShareRepositoryResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
@@ -89,7 +89,7 @@ func (r *ProjectShareRepositoryResource) Configure(ctx context.Context, req reso
 		return
 	}
 
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
 
 	supported, err := util.CheckVersion(r.ProviderData.ArtifactoryVersion, "7.90.1")
 	if err != nil {
@@ -137,7 +137,7 @@ func (r *ProjectShareRepositoryResource) Create(ctx context.Context, req resourc
 	}
 
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -180,7 +180,7 @@ func (r *ProjectShareRepositoryResource) Read(ctx context.Context, req resource.
 	}
 
 	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -229,7 +229,7 @@ func (r *ProjectShareRepositoryResource) Delete(ctx context.Context, req resourc
 	}
 
 	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -28,7 +28,7 @@ func NewProjectShareRepositoryWithAllResource() resource.Resource {
 }
 
 type ProjectShareRepositoryWithAllResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
@@ -76,7 +76,7 @@ func (r *ProjectShareRepositoryWithAllResource) Configure(ctx context.Context, r
 		return
 	}
 
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
 
 	supported, err := util.CheckVersion(r.ProviderData.ArtifactoryVersion, "7.90.1")
 	if err != nil {
@@ -117,10 +117,12 @@ func (r *ProjectShareRepositoryWithAllResource) Create(ctx context.Context, req
 
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
 	}
 
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	// Save data into Terraform state
@@ -162,7 +164,7 @@ func (r *ProjectShareRepositoryWithAllResource) Read(ctx context.Context, req re
 	}
 
 	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -209,10 +211,12 @@ func (r *ProjectShareRepositoryWithAllResource) Delete(ctx context.Context, req
 
 	if err != nil {
 		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
 	}
 
 	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	// If the logic reaches here, it implicitly succeeded and will remove
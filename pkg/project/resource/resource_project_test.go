@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
 	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
@@ -19,12 +21,12 @@ func TestAccProject_UpgradeFromSDKv2(t *testing.T) {
 	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
 	resourceName := fmt.Sprintf("project.%s", name)
 
-	username1 := fmt.Sprintf("user1%s", strings.ToLower(acctest.RandSeq(5)))
+	username1 := fmt.Sprintf("tftestuser1%s", strings.ToLower(acctest.RandSeq(5)))
 	email1 := username1 + "@tempurl.org"
-	username2 := fmt.Sprintf("user2%s", strings.ToLower(acctest.RandSeq(5)))
+	username2 := fmt.Sprintf("tftestuser2%s", strings.ToLower(acctest.RandSeq(5)))
 	email2 := username2 + "@tempurl.org"
-	group1 := fmt.Sprintf("group1%s", strings.ToLower(acctest.RandSeq(5)))
-	group2 := fmt.Sprintf("group2%s", strings.ToLower(acctest.RandSeq(5)))
+	group1 := fmt.Sprintf("tftestgroup1%s", strings.ToLower(acctest.RandSeq(5)))
+	group2 := fmt.Sprintf("tftestgroup2%s", strings.ToLower(acctest.RandSeq(5)))
 	repo1 := fmt.Sprintf("repo%s", strings.ToLower(acctest.RandSeq(6)))
 	repo2 := fmt.Sprintf("repo%s", strings.ToLower(acctest.RandSeq(6)))
 
@@ -447,16 +449,271 @@ func TestAccProject_UpdateKey(t *testing.T) {
 	})
 }
 
+func TestAccProject_preventRename(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			prevent_rename = true
+		}
+	`
+
+	key1 := strings.ToLower(acctest.RandSeq(6))
+	config := util.ExecuteTemplate("TestAccProject", template, map[string]string{
+		"name":        name,
+		"project_key": key1,
+	})
+
+	configWithNewKey := util.ExecuteTemplate("TestAccProject", template, map[string]string{
+		"name":        name,
+		"project_key": strings.ToLower(acctest.RandSeq(6)),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", key1),
+					resource.TestCheckResourceAttr(resourceName, "prevent_rename", "true"),
+				),
+			},
+			{
+				Config:      configWithNewKey,
+				ExpectError: regexp.MustCompile(".*Project key change prevented.*"),
+			},
+		},
+	})
+}
+
+func TestAccProject_importWithMemberships(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	username := fmt.Sprintf("tftestuser%s", strings.ToLower(acctest.RandSeq(5)))
+
+	config := util.ExecuteTemplate("TestAccProject", `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name = "{{ .username }}"
+			email = "{{ .username }}@tempurl.org"
+			password = "Password!123"
+		}
+
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_user_resource = false
+			use_project_role_resource = false
+
+			member {
+				name = artifactory_managed_user.{{ .username }}.name
+				roles = ["Developer"]
+			}
+
+			role {
+				name         = "qa"
+				description  = "QA role"
+				type         = "CUSTOM"
+				environments = ["DEV"]
+				actions      = ["READ_REPOSITORY", "READ_BUILD"]
+			}
+		}
+	`, map[string]string{
+		"name":        name,
+		"project_key": strings.ToLower(acctest.RandSeq(6)),
+		"username":    username,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "role.#", "1"),
+				),
+			},
+			{
+				// Members and roles are imported, and the use_project_*_resource flags
+				// match the configuration, so the plan after import is empty
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccProject_adoptExisting(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+
+	config := util.ExecuteTemplate("TestAccProject", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			description = "adopted"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			adopt_existing = true
+		}
+	`, map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+
+			// Project created outside Terraform, e.g. manually in the UI
+			resp, err := acctest.GetTestResty(t).R().
+				SetBody(project.ProjectAPIModel{
+					Key:          projectKey,
+					DisplayName:  name,
+					StorageQuota: -1,
+				}).
+				Post(project.ProjectsUrl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.IsError() {
+				t.Fatalf("failed to create project %s: %s", projectKey, resp.String())
+			}
+		},
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "description", "adopted"),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts", "adopt_existing"},
+			},
+		},
+	})
+}
+
+func TestAccProject_unmanagedMembersAction(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			unmanaged_members_action = "{{ .action }}"
+		}
+	`
+
+	config := func(action string) string {
+		return util.ExecuteTemplate("TestAccProject", template, map[string]string{
+			"name":        name,
+			"project_key": projectKey,
+			"action":      action,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("ignore"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_members_action", "ignore"),
+				),
+			},
+			{
+				// Member added outside Terraform doesn't show up as drift
+				PreConfig: func() {
+					_, err := acctest.GetTestResty(t).R().
+						SetPathParams(map[string]string{
+							"projectKey": projectKey,
+							"name":       "admin",
+						}).
+						SetBody(project.MemberAPIModel{
+							Name:  "admin",
+							Roles: []string{"Viewer"},
+						}).
+						Put(project.ProjectUsersUrl)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   config("ignore"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("warn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_members_action", "warn"),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "0"),
+				),
+			},
+			{
+				Config:             config("remove"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccProject_full(t *testing.T) {
 	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
 	resourceName := fmt.Sprintf("project.%s", name)
 
-	username1 := fmt.Sprintf("user1%s", strings.ToLower(acctest.RandSeq(5)))
+	username1 := fmt.Sprintf("tftestuser1%s", strings.ToLower(acctest.RandSeq(5)))
 	email1 := username1 + "@tempurl.org"
-	username2 := fmt.Sprintf("user2%s", strings.ToLower(acctest.RandSeq(5)))
+	username2 := fmt.Sprintf("tftestuser2%s", strings.ToLower(acctest.RandSeq(5)))
 	email2 := username2 + "@tempurl.org"
-	group1 := fmt.Sprintf("group1%s", strings.ToLower(acctest.RandSeq(5)))
-	group2 := fmt.Sprintf("group2%s", strings.ToLower(acctest.RandSeq(5)))
+	group1 := fmt.Sprintf("tftestgroup1%s", strings.ToLower(acctest.RandSeq(5)))
+	group2 := fmt.Sprintf("tftestgroup2%s", strings.ToLower(acctest.RandSeq(5)))
 	repo1 := fmt.Sprintf("repo%s", strings.ToLower(acctest.RandSeq(6)))
 	repo2 := fmt.Sprintf("repo%s", strings.ToLower(acctest.RandSeq(6)))
 
@@ -530,6 +787,7 @@ func TestAccProject_full(t *testing.T) {
 			max_storage_in_gibibytes = {{ .max_storage_in_gibibytes }}
 			block_deployments_on_limit = {{ .block_deployments_on_limit }}
 			email_notification = {{ .email_notification }}
+			quota_warning_threshold = 90
 
 			use_project_group_resource = false
 			use_project_user_resource = false
@@ -620,9 +878,12 @@ func TestAccProject_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", fmt.Sprintf("%d", params["max_storage_in_gibibytes"])),
 					resource.TestCheckResourceAttr(resourceName, "block_deployments_on_limit", fmt.Sprintf("%t", params["block_deployments_on_limit"])),
 					resource.TestCheckResourceAttr(resourceName, "email_notification", fmt.Sprintf("%t", params["email_notification"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_members", fmt.Sprintf("%t", params["manage_members"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_resources", fmt.Sprintf("%t", params["manage_resources"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.index_resources", fmt.Sprintf("%t", params["index_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_members", fmt.Sprintf("%t", params["manage_members"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_resources", fmt.Sprintf("%t", params["manage_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.index_resources", fmt.Sprintf("%t", params["index_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "quota_warning_threshold", "90"),
+					resource.TestCheckResourceAttr(resourceName, "quota_exceeded", "false"),
+					resource.TestCheckResourceAttr(resourceName, "over_soft_limit", "false"),
 					resource.TestCheckResourceAttr(resourceName, "use_project_user_resource", "false"),
 					resource.TestCheckResourceAttr(resourceName, "use_project_group_resource", "false"),
 					resource.TestCheckResourceAttr(resourceName, "use_project_role_resource", "false"),
@@ -654,9 +915,9 @@ func TestAccProject_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", fmt.Sprintf("%d", updateParams["max_storage_in_gibibytes"])),
 					resource.TestCheckResourceAttr(resourceName, "block_deployments_on_limit", fmt.Sprintf("%t", updateParams["block_deployments_on_limit"])),
 					resource.TestCheckResourceAttr(resourceName, "email_notification", fmt.Sprintf("%t", updateParams["email_notification"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_members", fmt.Sprintf("%t", updateParams["manage_members"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_resources", fmt.Sprintf("%t", updateParams["manage_resources"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.index_resources", fmt.Sprintf("%t", updateParams["index_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_members", fmt.Sprintf("%t", updateParams["manage_members"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_resources", fmt.Sprintf("%t", updateParams["manage_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.index_resources", fmt.Sprintf("%t", updateParams["index_resources"])),
 				),
 			},
 			{
@@ -787,9 +1048,9 @@ func TestAccProject_migrate_schema(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", fmt.Sprintf("%d", params["max_storage_in_gibibytes"])),
 					resource.TestCheckResourceAttr(resourceName, "block_deployments_on_limit", fmt.Sprintf("%t", params["block_deployments_on_limit"])),
 					resource.TestCheckResourceAttr(resourceName, "email_notification", fmt.Sprintf("%t", params["email_notification"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_members", fmt.Sprintf("%t", params["manage_members"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_resources", fmt.Sprintf("%t", params["manage_resources"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.index_resources", fmt.Sprintf("%t", params["index_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_members", fmt.Sprintf("%t", params["manage_members"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_resources", fmt.Sprintf("%t", params["manage_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.index_resources", fmt.Sprintf("%t", params["index_resources"])),
 					resource.TestCheckResourceAttr(resourceName, "role.#", "2"),
 				),
 			},
@@ -802,9 +1063,9 @@ func TestAccProject_migrate_schema(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", fmt.Sprintf("%d", updateParams["max_storage_in_gibibytes"])),
 					resource.TestCheckResourceAttr(resourceName, "block_deployments_on_limit", fmt.Sprintf("%t", updateParams["block_deployments_on_limit"])),
 					resource.TestCheckResourceAttr(resourceName, "email_notification", fmt.Sprintf("%t", updateParams["email_notification"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_members", fmt.Sprintf("%t", updateParams["manage_members"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.manage_resources", fmt.Sprintf("%t", updateParams["manage_resources"])),
-					resource.TestCheckResourceAttr(resourceName, "admin_privileges.0.index_resources", fmt.Sprintf("%t", updateParams["index_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_members", fmt.Sprintf("%t", updateParams["manage_members"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.manage_resources", fmt.Sprintf("%t", updateParams["manage_resources"])),
+					resource.TestCheckResourceAttr(resourceName, "admin_privileges.index_resources", fmt.Sprintf("%t", updateParams["index_resources"])),
 					resource.TestCheckResourceAttr(resourceName, "use_project_role_resource", "true"),
 					resource.TestCheckResourceAttr(resourceName, "role.#", "0"),
 				),
@@ -812,3 +1073,566 @@ func TestAccProject_migrate_schema(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProject_storageQuotaUnit(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+
+	params := map[string]interface{}{
+		"name":               name,
+		"project_key":        strings.ToLower(acctest.RandSeq(6)),
+		"max_storage":        2,
+		"storage_quota_unit": "TB",
+	}
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			max_storage = {{ .max_storage }}
+			storage_quota_unit = "{{ .storage_quota_unit }}"
+		}
+	`
+	config := util.ExecuteTemplate("TestAccProject", template, params)
+
+	updateParams := map[string]interface{}{
+		"name":               name,
+		"project_key":        params["project_key"],
+		"max_storage":        2048,
+		"storage_quota_unit": "GB",
+	}
+	updatedConfig := util.ExecuteTemplate("TestAccProject", template, updateParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_storage", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_quota_unit", "TB"),
+					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", "2048"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_storage", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "storage_quota_unit", "GB"),
+					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", "2048"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_waitForReady(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+
+	params := map[string]interface{}{
+		"name":        name,
+		"project_key": strings.ToLower(acctest.RandSeq(6)),
+	}
+
+	config := util.ExecuteTemplate("TestAccProject", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			wait_for_ready = true
+			poll_interval = 1
+			max_wait = 30
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_ready", "true"),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_wait", "30"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_ready", "poll_interval", "max_wait"},
+			},
+		},
+	})
+}
+
+func TestAccProject_timeouts(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+
+	params := map[string]interface{}{
+		"name":        name,
+		"project_key": strings.ToLower(acctest.RandSeq(6)),
+	}
+
+	config := util.ExecuteTemplate("TestAccProject", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			timeouts {
+				create = "5m"
+				delete = "10m"
+			}
+		}
+	`, params)
+
+	invalidConfig := util.ExecuteTemplate("TestAccProject", `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			timeouts {
+				create = "five minutes"
+			}
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				ExpectError: regexp.MustCompile(".*value must be a positive duration.*"),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "5m"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.delete", "10m"),
+					resource.TestCheckNoResourceAttr(resourceName, "timeouts.read"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccProject_protectedProjectKeys(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+
+	template := `
+		{{ if .protected }}
+		provider "project" {
+			protected_project_keys = ["{{ .project_key }}"]
+		}
+		{{ end }}
+
+		resource "project" "{{ .name }}" {
+			key = "{{ .key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProject", template, map[string]interface{}{
+		"name":        name,
+		"project_key": projectKey,
+		"key":         projectKey,
+		"protected":   false,
+	})
+
+	keyChangedConfig := util.ExecuteTemplate("TestAccProject", template, map[string]interface{}{
+		"name":        name,
+		"project_key": projectKey,
+		"key":         strings.ToLower(acctest.RandSeq(6)),
+		"protected":   true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceName, "key", projectKey),
+			},
+			{
+				Config:      keyChangedConfig,
+				ExpectError: regexp.MustCompile(`.*Protected project.*`),
+			},
+			{
+				// remove the protection so the project can be destroyed
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceName, "key", projectKey),
+			},
+		},
+	})
+}
+
+func TestAccProject_deletionProtection(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			deletion_protection = {{ .deletion_protection }}
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+	`
+
+	protectedConfig := util.ExecuteTemplate("TestAccProject", template, map[string]interface{}{
+		"name":                name,
+		"project_key":         projectKey,
+		"deletion_protection": true,
+	})
+
+	unprotectedConfig := util.ExecuteTemplate("TestAccProject", template, map[string]interface{}{
+		"name":                name,
+		"project_key":         projectKey,
+		"deletion_protection": false,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: protectedConfig,
+				Check:  resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+			},
+			{
+				Config:      protectedConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*Project deletion protected.*`),
+			},
+			{
+				// remove the protection so the project can be destroyed
+				Config: unprotectedConfig,
+				Check:  resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
+func TestAccProject_forceDeleteWithRepos(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+	repo := fmt.Sprintf("repo%s", strings.ToLower(acctest.RandSeq(6)))
+
+	config := util.ExecuteTemplate("TestAccProject", `
+		resource "artifactory_local_generic_repository" "{{ .repo }}" {
+			key = "{{ .repo }}"
+
+			lifecycle {
+				ignore_changes = [project_key]
+			}
+		}
+
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			force_delete_with_repos = true
+		}
+	`, map[string]string{
+		"name":        name,
+		"project_key": projectKey,
+		"repo":        repo,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_delete_with_repos", "true"),
+					// Repository assigned outside of the project resource, which must not block the destroy
+					func(_ *terraform.State) error {
+						resp, err := acctest.GetTestResty(t).R().
+							SetPathParams(map[string]string{
+								"projectKey": projectKey,
+								"repoKey":    repo,
+							}).
+							SetQueryParam("force", "true").
+							Put(project.ProjectsUrl + "/_/attach/repositories/{repoKey}/{projectKey}")
+						if err != nil {
+							return err
+						}
+						if resp.IsError() {
+							return fmt.Errorf("failed to assign repo %s: %s", repo, resp.String())
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_membersHandover(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+	username := fmt.Sprintf("tftestuser%s", strings.ToLower(acctest.RandSeq(5)))
+
+	template := `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name = "{{ .username }}"
+			email = "{{ .username }}@tempurl.org"
+			password = "Password!123"
+		}
+
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_user_resource = {{ .use_project_user_resource }}
+
+			{{ if not .use_project_user_resource }}
+			member {
+				name = artifactory_managed_user.{{ .username }}.name
+				roles = ["Developer"]
+			}
+			{{ end }}
+		}
+
+		{{ if .use_project_user_resource }}
+		import {
+			to = project_user.{{ .username }}
+			id = "{{ .project_key }}:{{ .username }}"
+		}
+
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = ["Developer"]
+		}
+		{{ end }}
+	`
+
+	memberConfig := util.ExecuteTemplate("TestAccProject", template, map[string]interface{}{
+		"name":                      name,
+		"project_key":               projectKey,
+		"username":                  username,
+		"use_project_user_resource": false,
+	})
+
+	projectUserConfig := util.ExecuteTemplate("TestAccProject", template, map[string]interface{}{
+		"name":                      name,
+		"project_key":               projectKey,
+		"username":                  username,
+		"use_project_user_resource": true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: memberConfig,
+				Check:  resource.TestCheckResourceAttr(resourceName, "member.#", "1"),
+			},
+			{
+				// The membership is imported into project_user instead of being re-created
+				Config: projectUserConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fmt.Sprintf("project_user.%s", username), plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "use_project_user_resource", "true"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("project_user.%s", username), "roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_validateQuotaAgainstUsage(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+
+	params := map[string]interface{}{
+		"name":                     name,
+		"project_key":              strings.ToLower(acctest.RandSeq(6)),
+		"max_storage_in_gibibytes": 10,
+	}
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			max_storage_in_gibibytes = {{ .max_storage_in_gibibytes }}
+			validate_quota_against_usage = true
+		}
+	`
+	config := util.ExecuteTemplate("TestAccProject", template, params)
+
+	// Lowering the quota of a project without any storage used passes the validation
+	updateParams := map[string]interface{}{
+		"name":                     name,
+		"project_key":              params["project_key"],
+		"max_storage_in_gibibytes": 1,
+	}
+	updatedConfig := util.ExecuteTemplate("TestAccProject", template, updateParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "validate_quota_against_usage", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", "10"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_enforceQuotaBlockDeployments(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			max_storage_in_gibibytes = 1
+			{{ .quota_attribute }}
+		}
+	`
+	makeConfig := func(quotaAttribute string) string {
+		return util.ExecuteTemplate("TestAccProject", template, map[string]string{
+			"name":            name,
+			"project_key":     projectKey,
+			"quota_attribute": quotaAttribute,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: makeConfig("enforce_quota_block_deployments = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enforce_quota_block_deployments", "true"),
+					resource.TestCheckResourceAttr(resourceName, "block_deployments_on_limit", "true"),
+				),
+			},
+			{
+				// The deprecated attribute keeps working and both stay in sync
+				Config: makeConfig("block_deployments_on_limit = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enforce_quota_block_deployments", "false"),
+					resource.TestCheckResourceAttr(resourceName, "block_deployments_on_limit", "false"),
+				),
+			},
+			{
+				Config:      makeConfig("enforce_quota_block_deployments = true\nblock_deployments_on_limit = true"),
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			{
+				Config: makeConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enforce_quota_block_deployments", "false"),
+					resource.TestCheckResourceAttr(resourceName, "block_deployments_on_limit", "false"),
+				),
+			},
+		},
+	})
+}
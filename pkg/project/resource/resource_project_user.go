@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,16 +32,41 @@ func NewProjectUserResource() resource.Resource {
 }
 
 type ProjectUserResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData ProviderMetadata
 	TypeName     string
 }
 
 type ProjectUserResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	ProjectKey        types.String `tfsdk:"project_key"`
-	Roles             types.Set    `tfsdk:"roles"`
-	IgnoreMissingUser types.Bool   `tfsdk:"ignore_missing_user"`
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	ProjectKey            types.String `tfsdk:"project_key"`
+	Roles                 types.Set    `tfsdk:"roles"`
+	IgnoreMissingUser     types.Bool   `tfsdk:"ignore_missing_user"`
+	AllowLastAdminRemoval types.Bool   `tfsdk:"allow_last_admin_removal"`
+	ExpiresAt             types.String `tfsdk:"expires_at"`
+	Expired               types.Bool   `tfsdk:"expired"`
+	Timeouts              types.Object `tfsdk:"timeouts"`
+}
+
+// isExpired reports whether `expires_at` has passed
+func (m ProjectUserResourceModel) isExpired() bool {
+	if m.ExpiresAt.IsNull() || m.ExpiresAt.IsUnknown() {
+		return false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !time.Now().Before(expiresAt)
+}
+
+// setExpired decides on apply whether the membership has expired, so `expired` is known in
+// state even when `expires_at` was unknown at plan time, or passed since.
+func (m *ProjectUserResourceModel) setExpired() bool {
+	m.Expired = types.BoolValue(m.isExpired())
+	return m.Expired.ValueBool()
 }
 
 type ProjectUserAPIModel struct {
@@ -92,6 +119,26 @@ func (r *ProjectUserResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:     booldefault.StaticBool(false),
 				Description: "When set to `true`, the resource will not fail if the user does not exist. Default to `false`. This is useful when the user is externally managed and the local account wasn't created yet.",
 			},
+			"allow_last_admin_removal": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, the user can be removed from the project, or have the 'Project Admin' role removed from its roles, even if it is the last member holding that role. Default to `false`.",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					IsRFC3339(),
+				},
+				Description: "RFC 3339 timestamp, e.g. `2025-01-31T18:00:00Z`, after which the membership expires. Once expired, refreshing the resource emits a warning and the next apply removes the user from the project while keeping the resource in state. Extending or removing `expires_at` grants the membership again.",
+			},
+			"expired": schema.BoolAttribute{
+				Computed:    true,
+				Description: "`true` when the membership has been removed from the project because `expires_at` has passed.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock,
 		},
 		Description: "Add a user as project member. Element has one to one mapping with the [JFrog Project Users API](https://jfrog.com/help/r/jfrog-rest-apis/add-or-update-user-in-project). Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
 	}
@@ -102,7 +149,62 @@ func (r *ProjectUserResource) Configure(ctx context.Context, req resource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+// ModifyPlan checks the roles when the provider `validate_roles` is set, and plans `expired`
+// as unknown once `expires_at` has passed so the next apply removes the membership. Whether
+// it expired is only decided on apply: a value planned from the current time could change
+// before the apply, which Terraform rejects as an inconsistent plan.
+func (r *ProjectUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ProjectKey.IsUnknown() {
+		resp.Diagnostics.Append(r.ProviderData.validateRoleNames(ctx, path.Root("roles"), plan.ProjectKey.ValueString(), knownStrings(plan.Roles), nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// On create, or any other change, `expired` is already unknown
+	if req.State.Raw.IsNull() || plan.Expired.IsUnknown() {
+		return
+	}
+
+	var state ProjectUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Expired.ValueBool() && plan.isExpired() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired"), types.BoolUnknown())...)
+	}
+}
+
+// checkLastAdminRemoval prevents the user from losing the Project Admin role held in state,
+// by being removed (nil roles) or by having its roles updated, if it is the last member holding it
+func (r *ProjectUserResource) checkLastAdminRemoval(ctx context.Context, state ProjectUserResourceModel, roles []string, allowLastAdminRemoval bool) diag.Diagnostics {
+	if allowLastAdminRemoval {
+		return nil
+	}
+
+	var previousRoles []string
+	ds := state.Roles.ElementsAs(ctx, &previousRoles, false)
+	if ds.HasError() {
+		return ds
+	}
+
+	return append(ds, checkLastAdminRemoval(ctx, state.ProjectKey.ValueString(), usersMembershipType, state.Name.ValueString(), previousRoles, roles, r.ProviderData.Client)...)
 }
 
 func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -116,6 +218,13 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, plan.Timeouts, createTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	projectKey := plan.ProjectKey.ValueString()
 
 	var roles []string
@@ -129,17 +238,30 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		Roles: roles,
 	}
 
+	if plan.setExpired() {
+		resp.Diagnostics.AddWarning(
+			"Project membership expired",
+			fmt.Sprintf("expires_at %s has passed, user '%s' is not added to project '%s'", plan.ExpiresAt.ValueString(), user.Name, projectKey),
+		)
+		plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, user.Name))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       plan.Name.ValueString(),
 		}).
 		SetBody(user).
 		SetError(&projectError).
+		AddRetryCondition(retryOnMemberNotFound).
 		Put(ProjectUsersUrl)
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
 	}
 	if response.StatusCode() == http.StatusNotFound {
 		if plan.IgnoreMissingUser.ValueBool() {
@@ -155,7 +277,8 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 			return
 		}
 	} else if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		utilfw.UnableToCreateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, user.Name))
@@ -174,11 +297,19 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, state.Timeouts, readTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	projectKey := state.ProjectKey.ValueString()
 
 	var user ProjectUserAPIModel
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       state.Name.ValueString(),
@@ -192,12 +323,18 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	if response.StatusCode() == http.StatusNotFound {
+		// an expired membership is removed on purpose, keep it in state so it isn't re-created
+		if state.Expired.ValueBool() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+
 		// on read always ensure the resource is not part of the state if user or project_user are missing
 		// this will ensure its detected as deleted and re-created on plan/apply
 		resp.State.RemoveResource(ctx)
 		return
 	} else if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -215,6 +352,19 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		state.IgnoreMissingUser = types.BoolValue(false)
 	}
 
+	if state.AllowLastAdminRemoval.IsNull() {
+		state.AllowLastAdminRemoval = types.BoolValue(false)
+	}
+
+	// the user is still a member so the membership hasn't been removed yet
+	state.Expired = types.BoolValue(false)
+	if state.isExpired() {
+		resp.Diagnostics.AddWarning(
+			"Project membership expired",
+			fmt.Sprintf("expires_at %s has passed, user '%s' will be removed from project '%s' on the next apply", state.ExpiresAt.ValueString(), user.Name, projectKey),
+		)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -222,13 +372,21 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan ProjectUserResourceModel
+	var plan, state ProjectUserResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, ds := withTimeout(ctx, plan.Timeouts, updateTimeout)
+	resp.Diagnostics.Append(ds...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	defer cancel()
 
 	projectKey := plan.ProjectKey.ValueString()
 
@@ -243,17 +401,49 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		Roles: roles,
 	}
 
+	if plan.setExpired() {
+		resp.Diagnostics.Append(r.checkLastAdminRemoval(ctx, state, nil, plan.AllowLastAdminRemoval.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := deleteMember(ctx, projectKey, usersMembershipType, MemberAPIModel{Name: user.Name}, r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Project membership expired",
+			fmt.Sprintf("expires_at %s has passed, user '%s' is removed from project '%s'", plan.ExpiresAt.ValueString(), user.Name, projectKey),
+		)
+		plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, user.Name))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// an expired membership has already been removed, so it doesn't hold any role
+	if !state.Expired.ValueBool() {
+		resp.Diagnostics.Append(r.checkLastAdminRemoval(ctx, state, roles, plan.AllowLastAdminRemoval.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       plan.Name.ValueString(),
 		}).
 		SetBody(user).
 		SetError(&projectError).
+		AddRetryCondition(retryOnMemberNotFound).
 		Put(ProjectUsersUrl)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
 	}
 	if response.StatusCode() == http.StatusNotFound {
 		if plan.IgnoreMissingUser.ValueBool() {
@@ -269,7 +459,8 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 			return
 		}
 	} else if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		utilfw.UnableToUpdateResourceError(resp, apiErrorMessage(response, projectError))
+		return
 	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, user.Name))
@@ -289,10 +480,26 @@ func (r *ProjectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel, ds := withTimeout(ctx, state.Timeouts, deleteTimeout)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	projectKey := state.ProjectKey.ValueString()
 
+	// an expired membership has already been removed
+	if !state.Expired.ValueBool() {
+		resp.Diagnostics.Append(r.checkLastAdminRemoval(ctx, state, nil, state.AllowLastAdminRemoval.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"name":       state.Name.ValueString(),
@@ -304,7 +511,7 @@ func (r *ProjectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 	if response.IsError() && response.StatusCode() != http.StatusNotFound {
-		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		utilfw.UnableToDeleteResourceError(resp, apiErrorMessage(response, projectError))
 		return
 	}
 
@@ -0,0 +1,140 @@
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// projectUserValue returns a project_user object with the given expires_at and expired
+func projectUserValue(t *testing.T, objectType tftypes.Object, expiresAt, expired tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                       tftypes.NewValue(tftypes.String, "myproj:myuser"),
+		"name":                     tftypes.NewValue(tftypes.String, "myuser"),
+		"project_key":              tftypes.NewValue(tftypes.String, "myproj"),
+		"roles":                    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "Viewer")}),
+		"ignore_missing_user":      tftypes.NewValue(tftypes.Bool, false),
+		"allow_last_admin_removal": tftypes.NewValue(tftypes.Bool, false),
+		"expires_at":               expiresAt,
+		"expired":                  expired,
+		"timeouts":                 tftypes.NewValue(objectType.AttributeTypes["timeouts"], nil),
+	})
+}
+
+func TestProjectUserModifyPlan_expired(t *testing.T) {
+	ctx := context.Background()
+
+	r := &ProjectUserResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	past := tftypes.NewValue(tftypes.String, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))
+	future := tftypes.NewValue(tftypes.String, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	unknownString := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	unknownBool := tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)
+	notExpired := tftypes.NewValue(tftypes.Bool, false)
+	expired := tftypes.NewValue(tftypes.Bool, true)
+
+	testCases := []struct {
+		name            string
+		state           tftypes.Value
+		plan            tftypes.Value
+		expectedExpired types.Bool
+	}{
+		{
+			name:            "create with unknown expires_at",
+			state:           tftypes.NewValue(objectType, nil),
+			plan:            projectUserValue(t, objectType, unknownString, unknownBool),
+			expectedExpired: types.BoolUnknown(),
+		},
+		{
+			name:            "update to unknown expires_at",
+			state:           projectUserValue(t, objectType, future, notExpired),
+			plan:            projectUserValue(t, objectType, unknownString, unknownBool),
+			expectedExpired: types.BoolUnknown(),
+		},
+		{
+			name:            "expires_at not passed",
+			state:           projectUserValue(t, objectType, future, notExpired),
+			plan:            projectUserValue(t, objectType, future, notExpired),
+			expectedExpired: types.BoolValue(false),
+		},
+		{
+			name:            "expires_at passed",
+			state:           projectUserValue(t, objectType, past, notExpired),
+			plan:            projectUserValue(t, objectType, past, notExpired),
+			expectedExpired: types.BoolUnknown(),
+		},
+		{
+			name:            "membership already removed",
+			state:           projectUserValue(t, objectType, past, expired),
+			plan:            projectUserValue(t, objectType, past, expired),
+			expectedExpired: types.BoolValue(true),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tc.state},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tc.plan},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var plannedExpired types.Bool
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("expired"), &plannedExpired)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if !plannedExpired.Equal(tc.expectedExpired) {
+				t.Errorf("expected expired to be planned as %s, got %s", tc.expectedExpired, plannedExpired)
+			}
+		})
+	}
+}
+
+func TestProjectUserSetExpired(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expiresAt types.String
+		expected  bool
+	}{
+		{name: "no expires_at", expiresAt: types.StringNull(), expected: false},
+		{name: "expires_at not passed", expiresAt: types.StringValue(time.Now().Add(time.Hour).UTC().Format(time.RFC3339)), expected: false},
+		{name: "expires_at passed", expiresAt: types.StringValue(time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)), expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// expired is planned as unknown when expires_at was unknown at plan time
+			model := ProjectUserResourceModel{
+				ExpiresAt: tc.expiresAt,
+				Expired:   types.BoolUnknown(),
+			}
+
+			if got := model.setExpired(); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+			if model.Expired.IsUnknown() || model.Expired.ValueBool() != tc.expected {
+				t.Errorf("expected expired to be set to %t, got %s", tc.expected, model.Expired)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package project_test
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
 	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
@@ -59,11 +61,17 @@ func TestAccProjectUser_UpgradeFromSDKv2(t *testing.T) {
 			project_key = project.{{ .project_name }}.key
 			name = artifactory_managed_user.{{ .username }}.name
 			roles = {{ .roles }}
+			{{ if .allow_last_admin_removal }}
+			allow_last_admin_removal = true
+			{{ end }}
 		}
 	`
 
 	config := util.ExecuteTemplate("TestAccProjectUser", template, params)
 
+	params["allow_last_admin_removal"] = true
+	configAllowLastAdminRemoval := util.ExecuteTemplate("TestAccProjectUser", template, params)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() { acctest.PreCheck(t) },
 		Steps: []resource.TestStep{
@@ -98,6 +106,16 @@ func TestAccProjectUser_UpgradeFromSDKv2(t *testing.T) {
 				PlanOnly:         true,
 				ConfigPlanChecks: testutil.ConfigPlanChecks(fqrn),
 			},
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"artifactory": {
+						Source: "jfrog/artifactory",
+					},
+				},
+				Config: configAllowLastAdminRemoval,
+				Check:  resource.TestCheckResourceAttr(fqrn, "allow_last_admin_removal", "true"),
+			},
 		},
 	})
 }
@@ -106,7 +124,7 @@ func TestAccProjectUser_full(t *testing.T) {
 	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
 	projectKey := strings.ToLower(acctest.RandSeq(10))
 
-	username := fmt.Sprintf("user%s", strings.ToLower(acctest.RandSeq(5)))
+	username := fmt.Sprintf("tftestuser%s", strings.ToLower(acctest.RandSeq(5)))
 	email := username + "@tempurl.org"
 
 	resourceName := "project_user." + username
@@ -204,6 +222,174 @@ func TestAccProjectUser_full(t *testing.T) {
 	})
 }
 
+func TestAccProjectUser_validateRoles(t *testing.T) {
+	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	username := fmt.Sprintf("tftestuser%s", strings.ToLower(acctest.RandSeq(5)))
+	email := username + "@tempurl.org"
+
+	resourceName := "project_user." + username
+
+	template := `
+		provider "project" {
+			validate_roles = true
+		}
+
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .email }}"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_user_resource = true
+		}
+
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = {{ .roles }}
+		}
+	`
+
+	config := func(roles string) string {
+		return util.ExecuteTemplate("TestAccProjectUser", template, map[string]interface{}{
+			"project_name": projectName,
+			"project_key":  projectKey,
+			"username":     username,
+			"email":        email,
+			"roles":        roles,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(resourceName, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyProjectUser(username, projectKey, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["Developer"]`),
+				Check:  resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+			},
+			{
+				Config:      config(`["Developer", "tftest-unknown-role"]`),
+				ExpectError: regexp.MustCompile(`.*Unknown project role.*`),
+			},
+		},
+	})
+}
+
+func TestAccProjectUser_expires_at(t *testing.T) {
+	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	username := fmt.Sprintf("tftestuser%s", strings.ToLower(acctest.RandSeq(5)))
+	email := username + "@tempurl.org"
+
+	resourceName := "project_user." + username
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"username":     username,
+		"email":        email,
+		"expires_at":   "2099-01-01T00:00:00Z",
+	}
+
+	template := `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .email }}"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+			use_project_user_resource = true
+		}
+
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = ["Developer"]
+			expires_at = "{{ .expires_at }}"
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectUser", template, params)
+
+	params["expires_at"] = "2000-01-01T00:00:00Z"
+	configExpired := util.ExecuteTemplate("TestAccProjectUser", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(resourceName, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyProjectUser(username, projectKey, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expires_at", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "expired", "false"),
+				),
+			},
+			{
+				Config: configExpired,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expires_at", "2000-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "expired", "true"),
+					func(_ *terraform.State) error {
+						resp, err := verifyProjectUser(username, projectKey, acctest.GetTestResty(t).R())
+						if err != nil {
+							return err
+						}
+						if resp.StatusCode() != http.StatusNotFound {
+							return fmt.Errorf("expected expired user %s to be removed from project %s, got status %d", username, projectKey, resp.StatusCode())
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:           configExpired,
+				PlanOnly:         true,
+				ConfigPlanChecks: testutil.ConfigPlanChecks(resourceName),
+			},
+		},
+	})
+}
+
 func TestAccProjectUser_invalid_roles(t *testing.T) {
 	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
 	projectKey := strings.ToLower(acctest.RandSeq(10))
@@ -372,6 +558,7 @@ func TestAccProjectMember_missing_user_ignored(t *testing.T) {
 			name = "{{ .username }}"
 			roles = {{ .roles }}
 			ignore_missing_user = true
+			allow_last_admin_removal = true
 			{{ if .create_user }}
 			depends_on = [artifactory_managed_user.{{ .username }}]
 			{{ end }}
@@ -0,0 +1,357 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+const projectRepositoriesUrl = "/artifactory/api/repositories"
+
+// Virtual repositories aren't indexed themselves, the repositories they aggregate are
+const virtualRepositoryType = "VIRTUAL"
+
+func NewProjectXrayIndexingResource() resource.Resource {
+	return &ProjectXrayIndexingResource{
+		TypeName: "project_xray_indexing",
+	}
+}
+
+type ProjectXrayIndexingResource struct {
+	ProviderData ProviderMetadata
+	TypeName     string
+}
+
+type ProjectXrayIndexingResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProjectKey   types.String `tfsdk:"project_key"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	PackageTypes types.Set    `tfsdk:"package_types"`
+	Exclude      types.Set    `tfsdk:"exclude"`
+	Repositories types.Set    `tfsdk:"repositories"`
+}
+
+type RepositoryListAPIModel struct {
+	Key         string `json:"key"`
+	Type        string `json:"type"`
+	PackageType string `json:"packageType"`
+}
+
+type RepositoryXrayIndexAPIModel struct {
+	Key       string `json:"key"`
+	Rclass    string `json:"rclass"`
+	XrayIndex bool   `json:"xrayIndex"`
+}
+
+func (r *ProjectXrayIndexingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectXrayIndexingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project whose repositories are indexed.",
+			},
+			"enabled": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether Xray indexing is enabled for the selected repositories. A repository with a different setting, e.g. one assigned to the project later, is reported as drift and updated on the next apply.",
+			},
+			"package_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only select repositories with these package types, e.g. `docker` or `maven`. All package types are selected when not set.",
+			},
+			"exclude": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Keys of repositories which are never selected, e.g. because their indexing is managed elsewhere.",
+			},
+			"repositories": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Keys of the selected repositories as of the last refresh or apply.",
+			},
+		},
+		Description: "Enables or disables Xray indexing for all local, remote and federated repositories assigned to a project, optionally filtered by package type. Virtual repositories aren't indexed themselves so they are never selected. Destroying the resource leaves the indexing settings of the repositories unchanged. Requires a user assigned with the 'Administer the Platform' role.",
+	}
+}
+
+func (r *ProjectXrayIndexingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(ProviderMetadata)
+}
+
+// selectedRepositories lists the repositories of the project matching the filters, sorted by key
+func (m ProjectXrayIndexingResourceModel) selectedRepositories(ctx context.Context, client *resty.Client) ([]string, error) {
+	var packageTypes []string
+	if !m.PackageTypes.IsNull() {
+		if ds := m.PackageTypes.ElementsAs(ctx, &packageTypes, false); ds.HasError() {
+			return nil, fmt.Errorf("invalid package_types")
+		}
+	}
+
+	var exclude []string
+	if !m.Exclude.IsNull() {
+		if ds := m.Exclude.ElementsAs(ctx, &exclude, false); ds.HasError() {
+			return nil, fmt.Errorf("invalid exclude")
+		}
+	}
+
+	var repos []RepositoryListAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetQueryParam("project", m.ProjectKey.ValueString()).
+		SetResult(&repos).
+		SetError(&projectError).
+		Get(projectRepositoriesUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	keys := lo.FilterMap(repos, func(repo RepositoryListAPIModel, _ int) (string, bool) {
+		if strings.EqualFold(repo.Type, virtualRepositoryType) || lo.Contains(exclude, repo.Key) {
+			return "", false
+		}
+
+		if len(packageTypes) > 0 && !lo.ContainsBy(packageTypes, func(packageType string) bool {
+			return strings.EqualFold(packageType, repo.PackageType)
+		}) {
+			return "", false
+		}
+
+		return repo.Key, true
+	})
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+func readRepositoryXrayIndex(repoKey string, client *resty.Client) (RepositoryXrayIndexAPIModel, error) {
+	var repo RepositoryXrayIndexAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParam("key", repoKey).
+		SetResult(&repo).
+		SetError(&projectError).
+		Get(repositoryEndpoint)
+	if err != nil {
+		return repo, err
+	}
+	if resp.IsError() {
+		return repo, fmt.Errorf("failed to read repository '%s': %s", repoKey, apiErrorMessage(resp, projectError))
+	}
+
+	return repo, nil
+}
+
+// applyXrayIndexing updates the repositories whose indexing setting differs from enabled
+func applyXrayIndexing(ctx context.Context, repoKeys []string, enabled bool, client *resty.Client) error {
+	for _, repoKey := range repoKeys {
+		repo, err := readRepositoryXrayIndex(repoKey, client)
+		if err != nil {
+			return err
+		}
+
+		if repo.XrayIndex == enabled {
+			continue
+		}
+
+		tflog.Debug(ctx, "applyXrayIndexing", map[string]any{
+			"repoKey": repoKey,
+			"enabled": enabled,
+		})
+
+		var projectError ProjectErrorsResponse
+		resp, err := client.R().
+			SetPathParam("key", repoKey).
+			SetError(&projectError).
+			SetBody(RepositoryXrayIndexAPIModel{
+				Key:       repoKey,
+				Rclass:    repo.Rclass,
+				XrayIndex: enabled,
+			}).
+			Post(repositoryEndpoint)
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to update repository '%s': %s", repoKey, apiErrorMessage(resp, projectError))
+		}
+	}
+
+	return nil
+}
+
+func (r *ProjectXrayIndexingResource) apply(ctx context.Context, plan *ProjectXrayIndexingResourceModel) error {
+	repoKeys, err := plan.selectedRepositories(ctx, r.ProviderData.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := applyXrayIndexing(ctx, repoKeys, plan.Enabled.ValueBool(), r.ProviderData.Client); err != nil {
+		return err
+	}
+
+	repositories, ds := types.SetValueFrom(ctx, types.StringType, repoKeys)
+	if ds.HasError() {
+		return fmt.Errorf("failed to set repositories")
+	}
+
+	plan.ID = plan.ProjectKey
+	plan.Repositories = repositories
+
+	return nil
+}
+
+func (r *ProjectXrayIndexingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectXrayIndexingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectXrayIndexingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectXrayIndexingResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The repository list is empty rather than missing for a deleted project
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("projectKey", state.ProjectKey.ValueString()).
+		SetError(&projectError).
+		Get(ProjectUrl)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, apiErrorMessage(response, projectError))
+		return
+	}
+
+	repoKeys, err := state.selectedRepositories(ctx, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	for _, repoKey := range repoKeys {
+		repo, err := readRepositoryXrayIndex(repoKey, r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToRefreshResourceError(resp, err.Error())
+			return
+		}
+
+		// The setting of a single repository can't be represented, so any difference is
+		// reported as the opposite setting to plan an update of all selected repositories.
+		if repo.XrayIndex != state.Enabled.ValueBool() {
+			tflog.Info(ctx, "repository Xray indexing differs", map[string]any{
+				"repoKey":   repoKey,
+				"xrayIndex": repo.XrayIndex,
+			})
+			state.Enabled = types.BoolValue(repo.XrayIndex)
+			break
+		}
+	}
+
+	repositories, ds := types.SetValueFrom(ctx, types.StringType, repoKeys)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = state.ProjectKey
+	state.Repositories = repositories
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectXrayIndexingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectXrayIndexingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete leaves the indexing settings of the repositories unchanged.
+func (r *ProjectXrayIndexingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectXrayIndexingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), true)...)
+}
@@ -0,0 +1,106 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectXrayIndexing_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	resourceName := fmt.Sprintf("project_xray_indexing.%s", projectName)
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"repo_key":     repoKey,
+		"enabled":      true,
+	}
+
+	template := `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "xray_index"]
+			}
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_repository" "{{ .repo_key }}" {
+			project_key = project.{{ .project_name }}.key
+			key         = artifactory_local_generic_repository.{{ .repo_key }}.key
+		}
+
+		resource "project_xray_indexing" "{{ .project_name }}" {
+			project_key   = project.{{ .project_name }}.key
+			enabled       = {{ .enabled }}
+			package_types = ["generic"]
+
+			depends_on = [project_repository.{{ .repo_key }}]
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectXrayIndexing", template, params)
+
+	params["enabled"] = false
+	configDisabled := util.ExecuteTemplate("TestAccProjectXrayIndexing", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "repositories.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "repositories.0", repoKey),
+				),
+			},
+			{
+				Config:           config,
+				PlanOnly:         true,
+				ConfigPlanChecks: testutil.ConfigPlanChecks(resourceName),
+			},
+			{
+				Config: configDisabled,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "repositories.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        projectKey,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_key",
+				ImportStateVerifyIgnore:              []string{"package_types"},
+			},
+		},
+	})
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
 )
 
 type Role struct {
@@ -43,6 +48,7 @@ var readRoles = func(ctx context.Context, projectKey string, client *resty.Clien
 
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParam("projectKey", projectKey).
 		SetResult(&roles).
 		SetError(&projectError).
@@ -52,7 +58,7 @@ var readRoles = func(ctx context.Context, projectKey string, client *resty.Clien
 		return nil, err
 	}
 	if resp.IsError() {
-		return nil, fmt.Errorf("%s", projectError.String())
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("roles: %+v\n", roles))
@@ -114,6 +120,7 @@ var addRole = func(ctx context.Context, projectKey string, role Role, client *re
 
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParam("projectKey", projectKey).
 		SetBody(role).
 		SetError(&projectError).
@@ -128,7 +135,7 @@ var addRole = func(ctx context.Context, projectKey string, role Role, client *re
 		tflog.Debug(ctx, "addRole", map[string]interface{}{
 			"projectError": projectError,
 		})
-		return fmt.Errorf("%s", projectError.String())
+		return fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	return nil
@@ -139,6 +146,7 @@ var updateRole = func(ctx context.Context, projectKey string, role Role, client
 
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"roleName":   role.Name,
@@ -150,7 +158,7 @@ var updateRole = func(ctx context.Context, projectKey string, role Role, client
 		return err
 	}
 	if resp.IsError() {
-		return fmt.Errorf("%s", projectError.String())
+		return fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	return nil
@@ -175,6 +183,7 @@ var deleteRole = func(ctx context.Context, projectKey string, role Role, client
 
 	var projectError ProjectErrorsResponse
 	resp, err := client.R().
+		SetContext(ctx).
 		SetPathParams(map[string]string{
 			"projectKey": projectKey,
 			"roleName":   role.Name,
@@ -187,8 +196,74 @@ var deleteRole = func(ctx context.Context, projectKey string, role Role, client
 		return err
 	}
 	if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
-		return fmt.Errorf("%s", projectError.String())
+		return fmt.Errorf("%s", apiErrorMessage(resp, projectError))
 	}
 
 	return nil
 }
+
+// readRoleNames returns the names of the predefined and custom roles of the project, or nil
+// when the project doesn't exist yet, e.g. when it is created in the same apply.
+var readRoleNames = func(ctx context.Context, projectKey string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readRoleNames")
+
+	var roles []Role
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetContext(ctx).
+		SetPathParam("projectKey", projectKey).
+		SetResult(&roles).
+		SetError(&projectError).
+		Get(ProjectRolesUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	return lo.Map(roles, func(role Role, _ int) string {
+		return role.Name
+	}), nil
+}
+
+// validateRoleNames reports an error on the attribute for every role the project doesn't
+// have, so unknown roles fail the plan instead of the apply. pendingRoles are roles created
+// by the same apply. Nothing is checked unless `validate_roles` is set in the provider
+// configuration, or when the project doesn't exist yet.
+func (m ProviderMetadata) validateRoleNames(ctx context.Context, attrPath path.Path, projectKey string, roles, pendingRoles []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.ValidateRoles || len(roles) == 0 {
+		return diags
+	}
+
+	projectRoles, err := readRoleNames(ctx, projectKey, m.Client)
+	if err != nil {
+		diags.AddAttributeWarning(
+			attrPath,
+			"Failed to validate roles",
+			err.Error(),
+		)
+		return diags
+	}
+	if projectRoles == nil {
+		return diags
+	}
+
+	knownRoles := append(projectRoles, pendingRoles...)
+	for _, role := range lo.Uniq(roles) {
+		if !slices.Contains(knownRoles, role) {
+			diags.AddAttributeError(
+				attrPath,
+				"Unknown project role",
+				fmt.Sprintf("Role '%s' doesn't exist in project '%s'. Available roles: %s", role, projectKey, strings.Join(knownRoles, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
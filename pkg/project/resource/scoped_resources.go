@@ -0,0 +1,129 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+const (
+	buildsUrl          = "/artifactory/api/build"
+	releaseBundlesUrl  = "/lifecycle/api/v2/release_bundle/names"
+	pipelineSourcesUrl = "/pipelines/api/v1/pipelinesources"
+)
+
+type BuildsAPIModel struct {
+	Builds []BuildAPIModel `json:"builds"`
+}
+
+type BuildAPIModel struct {
+	URI string `json:"uri"`
+}
+
+type ReleaseBundlesAPIModel struct {
+	ReleaseBundles []ReleaseBundleAPIModel `json:"release_bundles"`
+}
+
+type ReleaseBundleAPIModel struct {
+	Name          string `json:"release_bundle_name"`
+	ProjectKey    string `json:"project_key"`
+	RepositoryKey string `json:"repository_key"`
+	LatestVersion string `json:"release_bundle_version_latest"`
+}
+
+type PipelineSourceAPIModel struct {
+	RepositoryFullName string `json:"repositoryFullName"`
+	Branch             string `json:"branch"`
+}
+
+// readBuilds returns the names of the builds in the project. Artifactory responds with 404
+// when there are no builds.
+var readBuilds = func(ctx context.Context, projectKey string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readBuilds")
+
+	var builds BuildsAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetQueryParam("project", projectKey).
+		SetResult(&builds).
+		SetError(&projectError).
+		Get(buildsUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return []string{}, nil
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	names := []string{}
+	for _, build := range builds.Builds {
+		name, err := url.PathUnescape(strings.TrimPrefix(build.URI, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode build name %s: %w", build.URI, err)
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// readReleaseBundles returns the Release Bundles v2 of the project. Platforms without the
+// Lifecycle service respond with 404, which is treated as no release bundles.
+var readReleaseBundles = func(ctx context.Context, projectKey string, client *resty.Client) ([]ReleaseBundleAPIModel, error) {
+	tflog.Debug(ctx, "readReleaseBundles")
+
+	var releaseBundles ReleaseBundlesAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetQueryParam("project", projectKey).
+		SetResult(&releaseBundles).
+		SetError(&projectError).
+		Get(releaseBundlesUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return []ReleaseBundleAPIModel{}, nil
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	return releaseBundles.ReleaseBundles, nil
+}
+
+// readPipelineSources returns the pipeline sources of the project as "repository:branch".
+// Platforms without Pipelines respond with 404, which is treated as no pipeline sources.
+var readPipelineSources = func(ctx context.Context, projectKey string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readPipelineSources")
+
+	var sources []PipelineSourceAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetQueryParam("projectKey", projectKey).
+		SetResult(&sources).
+		SetError(&projectError).
+		Get(pipelineSourcesUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return []string{}, nil
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	return lo.Map(sources, func(source PipelineSourceAPIModel, _ int) string {
+		return fmt.Sprintf("%s:%s", source.RepositoryFullName, source.Branch)
+	}), nil
+}
@@ -0,0 +1,112 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+const storageInfoUrl = "/artifactory/api/storageinfo"
+
+const defaultQuotaWarningThreshold = 90
+
+type StorageInfoAPIModel struct {
+	RepositoriesSummaryList []RepositorySummaryAPIModel `json:"repositoriesSummaryList"`
+}
+
+type RepositorySummaryAPIModel struct {
+	RepoKey          string `json:"repoKey"`
+	UsedSpaceInBytes int64  `json:"usedSpaceInBytes"`
+}
+
+// readStorageUsage sums the used space of the repositories assigned to the project. Artifactory
+// calculates the storage summary periodically so the result may lag behind recent deployments.
+var readStorageUsage = func(ctx context.Context, projectKey string, client *resty.Client) (int64, error) {
+	tflog.Debug(ctx, "readStorageUsage")
+
+	repoKeys, err := readRepos(ctx, projectKey, client)
+	if err != nil {
+		return 0, err
+	}
+
+	var storageInfo StorageInfoAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetContext(ctx).
+		SetResult(&storageInfo).
+		SetError(&projectError).
+		Get(storageInfoUrl)
+	if err != nil {
+		return 0, err
+	}
+	if resp.IsError() {
+		return 0, fmt.Errorf("%s", apiErrorMessage(resp, projectError))
+	}
+
+	usage := lo.SumBy(
+		storageInfo.RepositoriesSummaryList,
+		func(summary RepositorySummaryAPIModel) int64 {
+			if lo.Contains(repoKeys, summary.RepoKey) {
+				return summary.UsedSpaceInBytes
+			}
+			return 0
+		},
+	)
+
+	return usage, nil
+}
+
+// quotaStatus summarizes the storage usage of a project against its quota. Both are null
+// when the usage can't be read.
+type quotaStatus struct {
+	Exceeded      types.Bool
+	OverSoftLimit types.Bool
+}
+
+// checkQuotaUtilization warns when the project storage usage reaches the threshold percentage
+// of its quota, before deployments start getting blocked. Failing to read the usage is only
+// a warning as the check is advisory, and the quota status is then unknown.
+func checkQuotaUtilization(ctx context.Context, projectKey string, storageQuota, threshold int64, client *resty.Client) (quotaStatus, diag.Diagnostics) {
+	status := quotaStatus{
+		Exceeded:      types.BoolValue(false),
+		OverSoftLimit: types.BoolValue(false),
+	}
+	var diags diag.Diagnostics
+
+	// -1 is unlimited storage
+	if storageQuota <= 0 {
+		return status, diags
+	}
+
+	usage, err := readStorageUsage(ctx, projectKey, client)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to read project storage usage",
+			fmt.Sprintf("The storage usage of project '%s' couldn't be read so quota_exceeded and over_soft_limit are set to null: %s", projectKey, err),
+		)
+		return quotaStatus{
+			Exceeded:      types.BoolNull(),
+			OverSoftLimit: types.BoolNull(),
+		}, diags
+	}
+
+	utilization := float64(usage) / float64(storageQuota) * 100
+	status.Exceeded = types.BoolValue(usage >= storageQuota)
+	status.OverSoftLimit = types.BoolValue(utilization >= float64(threshold))
+
+	if status.OverSoftLimit.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("max_storage_in_gibibytes"),
+			"Project storage quota nearly exhausted",
+			fmt.Sprintf("Project '%s' uses %d of %d bytes (%.1f%%) of its storage quota, which is at or above the warning threshold of %d%%.", projectKey, usage, storageQuota, utilization, threshold),
+		)
+	}
+
+	return status, diags
+}
@@ -0,0 +1,81 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckQuotaUtilization(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		storageQuota          int64
+		usage                 int64
+		usageErr              error
+		expectedExceeded      types.Bool
+		expectedOverSoftLimit types.Bool
+		expectedWarnings      int
+	}{
+		{name: "unlimited storage", storageQuota: -1, expectedExceeded: types.BoolValue(false), expectedOverSoftLimit: types.BoolValue(false)},
+		{name: "below threshold", storageQuota: 100, usage: 50, expectedExceeded: types.BoolValue(false), expectedOverSoftLimit: types.BoolValue(false)},
+		{name: "over threshold", storageQuota: 100, usage: 95, expectedExceeded: types.BoolValue(false), expectedOverSoftLimit: types.BoolValue(true), expectedWarnings: 1},
+		{name: "exceeded", storageQuota: 100, usage: 100, expectedExceeded: types.BoolValue(true), expectedOverSoftLimit: types.BoolValue(true), expectedWarnings: 1},
+		{name: "usage can't be read", storageQuota: 100, usageErr: fmt.Errorf("403 Forbidden"), expectedExceeded: types.BoolNull(), expectedOverSoftLimit: types.BoolNull(), expectedWarnings: 1},
+	}
+
+	defer func(original func(context.Context, string, *resty.Client) (int64, error)) {
+		readStorageUsage = original
+	}(readStorageUsage)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			readStorageUsage = func(context.Context, string, *resty.Client) (int64, error) {
+				return tc.usage, tc.usageErr
+			}
+
+			status, diags := checkQuotaUtilization(context.Background(), "myproj", tc.storageQuota, 90, nil)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !status.Exceeded.Equal(tc.expectedExceeded) {
+				t.Errorf("expected quota_exceeded %s, got %s", tc.expectedExceeded, status.Exceeded)
+			}
+			if !status.OverSoftLimit.Equal(tc.expectedOverSoftLimit) {
+				t.Errorf("expected over_soft_limit %s, got %s", tc.expectedOverSoftLimit, status.OverSoftLimit)
+			}
+			if got := diags.WarningsCount(); got != tc.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.expectedWarnings, got, diags)
+			}
+		})
+	}
+}
+
+func TestRefreshQuotaStatus_withoutThreshold(t *testing.T) {
+	defer func(original func(context.Context, string, *resty.Client) (int64, error)) {
+		readStorageUsage = original
+	}(readStorageUsage)
+
+	readStorageUsage = func(context.Context, string, *resty.Client) (int64, error) {
+		t.Error("storage usage read without quota_warning_threshold")
+		return 0, nil
+	}
+
+	model := ProjectResourceModelV5{
+		Key:                   types.StringValue("myproj"),
+		QuotaWarningThreshold: types.Int64Null(),
+		QuotaExceeded:         types.BoolValue(true),
+		OverSoftLimit:         types.BoolValue(true),
+	}
+
+	if diags := model.refreshQuotaStatus(context.Background(), 100, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !model.QuotaExceeded.IsNull() || !model.OverSoftLimit.IsNull() {
+		t.Errorf("expected null quota status, got quota_exceeded %s and over_soft_limit %s", model.QuotaExceeded, model.OverSoftLimit)
+	}
+}
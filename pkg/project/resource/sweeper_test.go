@@ -0,0 +1,319 @@
+package project_test
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/samber/lo"
+)
+
+// Name prefixes of the projects, users, groups and roles created by the acceptance tests
+var sweepPrefixes = []string{
+	"tftest",
+	"test-project",
+}
+
+// Platform users and groups, created by the tests with the artifactory provider
+const (
+	platformUsersUrl  = "/access/api/v2/users"
+	platformGroupsUrl = "/access/api/v2/groups"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// Run with `go test ./pkg/project/resource -v -sweep=all` to remove what interrupted
+// acceptance test runs left behind.
+func init() {
+	resource.AddTestSweepers("project_user", &resource.Sweeper{
+		Name: "project_user",
+		F: func(_ string) error {
+			return sweepMembers("users", project.ProjectUsersUrl)
+		},
+	})
+
+	resource.AddTestSweepers("project_group", &resource.Sweeper{
+		Name: "project_group",
+		F: func(_ string) error {
+			return sweepMembers("groups", project.ProjectGroupsUrl)
+		},
+	})
+
+	resource.AddTestSweepers("project_role", &resource.Sweeper{
+		Name: "project_role",
+		F:    sweepRoles,
+	})
+
+	resource.AddTestSweepers("platform_user", &resource.Sweeper{
+		Name:         "platform_user",
+		F:            sweepPlatformUsers,
+		Dependencies: []string{"project_user"},
+	})
+
+	resource.AddTestSweepers("platform_group", &resource.Sweeper{
+		Name:         "platform_group",
+		F:            sweepPlatformGroups,
+		Dependencies: []string{"project_group"},
+	})
+
+	resource.AddTestSweepers("project", &resource.Sweeper{
+		Name:         "project",
+		F:            sweepProjects,
+		Dependencies: []string{"project_user", "project_group", "project_role"},
+	})
+}
+
+func isSweepable(name string) bool {
+	return lo.ContainsBy(sweepPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(strings.ToLower(name), prefix)
+	})
+}
+
+func listProjects(client *resty.Client) ([]project.ProjectAPIModel, error) {
+	var projects []project.ProjectAPIModel
+	resp, err := client.R().
+		SetResult(&projects).
+		Get(project.ProjectsUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("failed to list projects: %s", resp.String())
+	}
+
+	return projects, nil
+}
+
+// sweepMembers removes the test users or groups from every project, including projects
+// that aren't swept themselves.
+func sweepMembers(membershipType, memberUrl string) error {
+	client, err := acctest.GetSweeperResty()
+	if err != nil {
+		return err
+	}
+
+	projects, err := listProjects(client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, p := range projects {
+		var membership project.MembershipAPIModel
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"projectKey":     p.Key,
+				"membershipType": membershipType,
+			}).
+			SetResult(&membership).
+			Get(project.ProjectUrl + "/{membershipType}")
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if resp.IsError() {
+			errs = append(errs, fmt.Errorf("failed to list %s of project %s: %s", membershipType, p.Key, resp.String()))
+			continue
+		}
+
+		for _, member := range membership.Members {
+			if !isSweepable(member.Name) {
+				continue
+			}
+
+			log.Printf("[INFO] Removing %s %s from project %s", membershipType, member.Name, p.Key)
+			resp, err := client.R().
+				SetPathParams(map[string]string{
+					"projectKey": p.Key,
+					"name":       member.Name,
+				}).
+				Delete(memberUrl)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if resp.IsError() {
+				errs = append(errs, fmt.Errorf("failed to remove %s %s from project %s: %s", membershipType, member.Name, p.Key, resp.String()))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepRoles(_ string) error {
+	client, err := acctest.GetSweeperResty()
+	if err != nil {
+		return err
+	}
+
+	projects, err := listProjects(client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, p := range projects {
+		var roles []project.ProjectRoleAPIModel
+		resp, err := client.R().
+			SetPathParam("projectKey", p.Key).
+			SetResult(&roles).
+			Get(project.ProjectRolesUrl)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if resp.IsError() {
+			errs = append(errs, fmt.Errorf("failed to list roles of project %s: %s", p.Key, resp.String()))
+			continue
+		}
+
+		for _, role := range roles {
+			if role.Type != "CUSTOM" || !isSweepable(role.Name) {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting role %s of project %s", role.Name, p.Key)
+			resp, err := client.R().
+				SetPathParams(map[string]string{
+					"projectKey": p.Key,
+					"roleName":   role.Name,
+				}).
+				Delete(project.ProjectRoleUrl)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if resp.IsError() {
+				errs = append(errs, fmt.Errorf("failed to delete role %s of project %s: %s", role.Name, p.Key, resp.String()))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepProjects(_ string) error {
+	client, err := acctest.GetSweeperResty()
+	if err != nil {
+		return err
+	}
+
+	projects, err := listProjects(client)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, p := range projects {
+		if !isSweepable(p.DisplayName) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting project %s (%s)", p.Key, p.DisplayName)
+		resp, err := client.R().
+			SetPathParam("projectKey", p.Key).
+			Delete(project.ProjectUrl)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if resp.IsError() {
+			errs = append(errs, fmt.Errorf("failed to delete project %s: %s", p.Key, resp.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// listPlatformNames pages through the platform users or groups and returns their names
+func listPlatformNames(client *resty.Client, url string, names func(body map[string]interface{}) []string) ([]string, error) {
+	var all []string
+	cursor := ""
+	for {
+		var body map[string]interface{}
+		req := client.R().
+			SetQueryParam("limit", "1000").
+			SetResult(&body)
+		if cursor != "" {
+			req.SetQueryParam("cursor", cursor)
+		}
+
+		resp, err := req.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if resp.IsError() {
+			return nil, fmt.Errorf("failed to list %s: %s", url, resp.String())
+		}
+
+		all = append(all, names(body)...)
+
+		next, _ := body["cursor"].(string)
+		if next == "" || next == cursor {
+			return all, nil
+		}
+		cursor = next
+	}
+}
+
+// namesOf returns the nameKey field of the objects in the listKey array of a list response
+func namesOf(listKey, nameKey string) func(body map[string]interface{}) []string {
+	return func(body map[string]interface{}) []string {
+		items, _ := body[listKey].([]interface{})
+		return lo.FilterMap(items, func(item interface{}, _ int) (string, bool) {
+			object, _ := item.(map[string]interface{})
+			name, ok := object[nameKey].(string)
+			return name, ok
+		})
+	}
+}
+
+func sweepPlatform(kind, url string, names func(body map[string]interface{}) []string) error {
+	client, err := acctest.GetSweeperResty()
+	if err != nil {
+		return err
+	}
+
+	allNames, err := listPlatformNames(client, url, names)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range allNames {
+		if !isSweepable(name) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting %s %s", kind, name)
+		resp, err := client.R().
+			SetPathParam("name", name).
+			Delete(url + "/{name}")
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if resp.IsError() {
+			errs = append(errs, fmt.Errorf("failed to delete %s %s: %s", kind, name, resp.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sweepPlatformUsers(_ string) error {
+	return sweepPlatform("user", platformUsersUrl, namesOf("users", "username"))
+}
+
+func sweepPlatformGroups(_ string) error {
+	return sweepPlatform("group", platformGroupsUrl, namesOf("groups", "group_name"))
+}
@@ -0,0 +1,111 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	createTimeout = "create"
+	readTimeout   = "read"
+	updateTimeout = "update"
+	deleteTimeout = "delete"
+)
+
+// Apply to operations without a configured timeout. Reads cover `read_max_retries` with the
+// default backoff, writes also leave room for the default `max_wait` of a new project becoming
+// ready. Creating a project with a longer `max_wait` extends the create default by the difference.
+var defaultOperationTimeouts = map[string]time.Duration{
+	createTimeout: 5 * time.Minute,
+	readTimeout:   2 * time.Minute,
+	updateTimeout: 5 * time.Minute,
+	deleteTimeout: 5 * time.Minute,
+}
+
+var timeoutsAttrTypes = map[string]attr.Type{
+	createTimeout: types.StringType,
+	readTimeout:   types.StringType,
+	updateTimeout: types.StringType,
+	deleteTimeout: types.StringType,
+}
+
+type TimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+func timeoutAttribute(operation string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			IsDuration(),
+		},
+		Description: fmt.Sprintf("Time allowed for the %s operation, as a duration string, e.g. `30s` or `10m`. Default to `%.0fm`.", operation, defaultOperationTimeouts[operation].Minutes()),
+	}
+}
+
+var timeoutsBlock = schema.SingleNestedBlock{
+	Attributes: map[string]schema.Attribute{
+		createTimeout: timeoutAttribute(createTimeout),
+		readTimeout:   timeoutAttribute(readTimeout),
+		updateTimeout: timeoutAttribute(updateTimeout),
+		deleteTimeout: timeoutAttribute(deleteTimeout),
+	},
+	Description: "Time allowed for each operation, including retries, before it fails. Raise these on large instances where applying changes takes longer.",
+}
+
+// withTimeout returns a context that is cancelled once the timeout configured for the
+// operation, or its default from defaultOperationTimeouts, has elapsed. API requests made
+// with the context fail with a deadline exceeded error instead of waiting any longer.
+func withTimeout(ctx context.Context, timeouts types.Object, operation string) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return withTimeoutDefault(ctx, timeouts, operation, defaultOperationTimeouts[operation])
+}
+
+// withTimeoutDefault is withTimeout with a different default for operations that wait
+// longer than usual, e.g. for the configured `max_wait`
+func withTimeoutDefault(ctx context.Context, timeouts types.Object, operation string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	timeout := defaultTimeout
+	if !timeouts.IsNull() && !timeouts.IsUnknown() {
+		var model TimeoutsModel
+		diags.Append(timeouts.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return ctx, func() {}, diags
+		}
+
+		value := map[string]types.String{
+			createTimeout: model.Create,
+			readTimeout:   model.Read,
+			updateTimeout: model.Update,
+			deleteTimeout: model.Delete,
+		}[operation]
+
+		if !value.IsNull() && !value.IsUnknown() {
+			d, err := time.ParseDuration(value.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("timeouts").AtName(operation),
+					"Invalid timeout",
+					err.Error(),
+				)
+				return ctx, func() {}, diags
+			}
+			timeout = d
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, diags
+}
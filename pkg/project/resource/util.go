@@ -2,18 +2,47 @@ package project
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/samber/lo"
 )
 
+// ProviderMetadata extends the shared provider metadata with the provider settings used by
+// resources and data sources.
+type ProviderMetadata struct {
+	util.ProviderMetadata
+	ProtectedProjectKeys []string
+	ValidateRoles        bool
+}
+
+// IsProtectedProjectKey reports whether the provider configuration protects the project
+// from being destroyed or having its key changed.
+func (m ProviderMetadata) IsProtectedProjectKey(projectKey string) bool {
+	return lo.ContainsBy(m.ProtectedProjectKeys, func(key string) bool {
+		return strings.EqualFold(key, projectKey)
+	})
+}
+
 type Equatable interface {
 	util.Identifiable
 	Equals(other Equatable) bool
 }
 
+func UnableToReadDataSourceError(resp *datasource.ReadResponse, err string) {
+	resp.Diagnostics.AddError(
+		"Unable to Read Data Source",
+		"An unexpected error occurred while attempting to read data source. "+
+			"Please retry the operation or report this issue to the provider developers.\n\n"+
+			"Error: "+err,
+	)
+}
+
 func RetryOnSpecificMsgBody(matchString string) func(response *resty.Response, err error) bool {
 	return func(response *resty.Response, err error) bool {
 		return regexp.MustCompile(matchString).MatchString(string(response.Body()[:]))
@@ -44,6 +73,22 @@ func (r ProjectErrorsResponse) String() string {
 	return errs
 }
 
+// apiErrorMessage describes a failed API response with its status code and the errors of the
+// body. The raw body is used when it doesn't hold JSON errors, e.g. when returned by a proxy.
+func apiErrorMessage(response *resty.Response, projectError ProjectErrorsResponse) string {
+	status := fmt.Sprintf("%d %s", response.StatusCode(), http.StatusText(response.StatusCode()))
+
+	message := projectError.String()
+	if message == "" {
+		message = strings.TrimSpace(response.String())
+	}
+	if message == "" {
+		return status
+	}
+
+	return fmt.Sprintf("%s: %s", status, message)
+}
+
 const ProjectRepositoryStatusEndpoint = "access/api/v1/projects/_/repositories/{repo_key}"
 
 type ProjectRepositoryStatusAPIModel struct {
@@ -54,3 +99,15 @@ type ProjectRepositoryStatusAPIModel struct {
 	SharedReadOnly        bool     `json:"shared_read_only"`
 	AssignedTo            string   `json:"assigned_to"`
 }
+
+// knownStrings returns the elements of the set of strings whose value is known at plan time
+func knownStrings(set types.Set) []string {
+	var values []string
+	for _, element := range set.Elements() {
+		if element, ok := element.(types.String); ok && !element.IsUnknown() && !element.IsNull() {
+			values = append(values, element.ValueString())
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,83 @@
+package project
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// tlsSettings holds the TLS configuration of the provider. Certificates are set either as
+// a file path or as PEM content.
+type tlsSettings struct {
+	CACertFile         string
+	CACertPEM          string
+	InsecureSkipVerify bool
+	ClientCertFile     string
+	ClientKeyFile      string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+}
+
+func (s tlsSettings) isSet() bool {
+	return s != tlsSettings{}
+}
+
+// configureTLS sets the TLS client configuration of the client. Custom CA certificates are
+// trusted in addition to the system trust store, so the platform can be reached behind a
+// corporate PKI without changing the OS configuration.
+func configureTLS(restyClient *resty.Client, settings tlsSettings) (*resty.Client, error) {
+	if !settings.isSet() {
+		return restyClient, nil
+	}
+
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: settings.InsecureSkipVerify,
+	}
+
+	caCertPEM := []byte(settings.CACertPEM)
+	if settings.CACertFile != "" {
+		content, err := os.ReadFile(settings.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+		}
+		caCertPEM = content
+	}
+
+	if len(caCertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caCertPEM) {
+			return nil, fmt.Errorf("no valid PEM encoded certificate found in the CA certificate")
+		}
+		config.RootCAs = pool
+	}
+
+	clientCertPEM := []byte(settings.ClientCertPEM)
+	clientKeyPEM := []byte(settings.ClientKeyPEM)
+	if settings.ClientCertFile != "" {
+		var err error
+		if clientCertPEM, err = os.ReadFile(settings.ClientCertFile); err != nil {
+			return nil, fmt.Errorf("failed to read client certificate file: %w", err)
+		}
+		if clientKeyPEM, err = os.ReadFile(settings.ClientKeyFile); err != nil {
+			return nil, fmt.Errorf("failed to read client key file: %w", err)
+		}
+	}
+
+	if len(clientCertPEM) > 0 {
+		certificate, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return restyClient.SetTLSClientConfig(config), nil
+}